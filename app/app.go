@@ -19,6 +19,7 @@ import (
 	"github.com/hdac-io/friday/x/bank"
 	distr "github.com/hdac-io/friday/x/distribution"
 	"github.com/hdac-io/friday/x/executionlayer"
+	executionlayerclient "github.com/hdac-io/friday/x/executionlayer/client"
 	"github.com/hdac-io/friday/x/genaccounts"
 	"github.com/hdac-io/friday/x/genutil"
 	"github.com/hdac-io/friday/x/gov"
@@ -51,7 +52,7 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler, executionlayerclient.ProposalHandler),
 		params.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
@@ -112,7 +113,7 @@ type FridayApp struct {
 
 // NewFridayApp returns a reference to an initialized FridayApp.
 func NewFridayApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
-	invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp)) *FridayApp {
+	invCheckPeriod uint, eeConfig executionlayer.EngineConfig, baseAppOptions ...func(*bam.BaseApp)) *FridayApp {
 
 	cdc := MakeCodec()
 
@@ -146,6 +147,8 @@ func NewFridayApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	distrSubspace := app.paramsKeeper.Subspace(distr.DefaultParamspace)
 	slashingSubspace := app.paramsKeeper.Subspace(slashing.DefaultParamspace)
 	govSubspace := app.paramsKeeper.Subspace(gov.DefaultParamspace)
+	executionLayerSubspace := app.paramsKeeper.Subspace(executionlayer.DefaultParamspace)
+	nicknameSubspace := app.paramsKeeper.Subspace(nickname.DefaultParamspace)
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
@@ -161,21 +164,28 @@ func NewFridayApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.slashingKeeper = slashing.NewKeeper(
 		app.cdc, keys[slashing.StoreKey], &stakingKeeper, slashingSubspace, slashing.DefaultCodespace,
 	)
-	// TODO - Need to change default value(socket path, protocol version)
-	app.nicknameKeeper = nickname.NewNicknameKeeper(keys[nickname.StoreKey], app.cdc, app.accountKeeper)
+	// TODO - Need to change default value(protocol version)
+	app.nicknameKeeper = nickname.NewNicknameKeeper(keys[nickname.StoreKey], app.cdc, nicknameSubspace, app.accountKeeper)
 	app.executionLayerKeeper = executionlayer.NewExecutionLayerKeeper(
 		app.cdc,
 		keys[executionlayer.HashMapStoreKey],
-		os.ExpandEnv("$HOME/.casperlabs/.casper-node.sock"),
+		executionLayerSubspace,
+		eeConfig,
+		app.Logger(),
 		app.accountKeeper,
 		app.nicknameKeeper,
 	)
 
+	// NOTE: the nickname keeper pays the prices of the names by the deploys of the execution layer keeper,
+	// which depends on the nickname keeper, so it is set after both are created
+	app.nicknameKeeper.SetDeployKeeper(app.executionLayerKeeper)
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper))
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(executionlayer.RouterKey, executionlayer.NewEngineUpgradeProposalHandler(app.executionLayerKeeper))
 	app.govKeeper = gov.NewKeeper(
 		app.cdc, keys[gov.StoreKey], app.paramsKeeper, govSubspace,
 		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter,
@@ -207,7 +217,7 @@ func NewFridayApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(executionlayer.ModuleName)
+	app.mm.SetOrderBeginBlockers(executionlayer.ModuleName, nickname.ModuleName)
 
 	app.mm.SetOrderEndBlockers(executionlayer.ModuleName)
 
@@ -230,7 +240,8 @@ func NewFridayApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(executionlayer.NewAnteHandler(app.executionLayerKeeper,
+		auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer)))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -10,17 +10,18 @@ import (
 
 	"github.com/hdac-io/friday/codec"
 	"github.com/hdac-io/friday/simapp"
+	"github.com/hdac-io/friday/x/executionlayer"
 
 	abci "github.com/hdac-io/tendermint/abci/types"
 )
 
 func TestFridaydExport(t *testing.T) {
 	db := db.NewMemDB()
-	fapp := NewFridayApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0)
+	fapp := NewFridayApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0, executionlayer.NewEngineConfig(executionlayer.MockEngineAddress))
 	setGenesis(fapp)
 
 	// Making a new app object with the db, so that initchain hasn't been called
-	newGapp := NewFridayApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0)
+	newGapp := NewFridayApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0, executionlayer.NewEngineConfig(executionlayer.MockEngineAddress))
 	_, _, err := newGapp.ExportAppStateAndValidators(false, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
@@ -28,7 +29,7 @@ func TestFridaydExport(t *testing.T) {
 // ensure that black listed addresses are properly set in bank keeper
 func TestBlackListedAddrs(t *testing.T) {
 	db := db.NewMemDB()
-	app := NewFridayApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0)
+	app := NewFridayApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0, executionlayer.NewEngineConfig(executionlayer.MockEngineAddress))
 
 	for acc := range maccPerms {
 		require.True(t, app.bankKeeper.BlacklistedAddr(app.supplyKeeper.GetModuleAddress(acc)))
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hdac-io/friday/baseapp"
 	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer"
 	"github.com/hdac-io/friday/x/staking"
 )
 
@@ -42,6 +43,6 @@ func NewFridayAppUNSAFE(logger log.Logger, db dbm.DB, traceStore io.Writer, load
 	invCheckPeriod uint, baseAppOptions ...func(*baseapp.BaseApp),
 ) (fapp *FridayApp, keyMain, keyStaking *sdk.KVStoreKey, stakingKeeper staking.Keeper) {
 
-	fapp = NewFridayApp(logger, db, traceStore, loadLatest, invCheckPeriod, baseAppOptions...)
+	fapp = NewFridayApp(logger, db, traceStore, loadLatest, invCheckPeriod, executionlayer.DefaultEngineConfig(), baseAppOptions...)
 	return fapp, fapp.keys[baseapp.MainStoreKey], fapp.keys[staking.StoreKey], fapp.stakingKeeper
 }
@@ -762,7 +762,8 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) (ctx sdk.Con
 }
 
 // runMsgs iterates through all the messages and executes them.
-func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode, txIndex int) (result sdk.Result) {
+// It also returns the gas cost of the execution engine reported by the messages executed as deploys.
+func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode, txIndex int) (result sdk.Result, engineGasUsed uint64) {
 	msgLogs := make(sdk.ABCIMessageLogs, 0, len(msgs))
 
 	data := make([]byte, 0, len(msgs))
@@ -780,7 +781,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode, txI
 	waitRunMsgs := sync.WaitGroup{}
 	waitRunMsgs.Add(len(msgs))
 
-	if mode != runTxModeCheck && len(msgs) > 1 {
+	if mode == runTxModeDeliver && len(msgs) > 1 {
 		candidateBlock := ctx.CandidateBlock()
 		candidateBlock.WaitGroup.Add(len(msgs) - 1)
 		ctx = ctx.WithCandidateBlock(candidateBlock)
@@ -808,7 +809,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode, txI
 			msgRoute := msg.Route()
 			handler := app.router.Route(msgRoute)
 
-			if msgRoute == executionlayer.ModuleName {
+			// the messages executed as deploys, of the executionlayer or the other modules,
+			// wait for the results of the block, so the next messages don't wait for them
+			_, isDeploy := msg.(executionlayer.DeployMsg)
+
+			if isDeploy {
 				currentMsgIndex++
 				msgCond.L.Unlock()
 				msgCond.Broadcast()
@@ -817,10 +822,15 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode, txI
 			if handler == nil {
 				msgResults.Store(msgIndex, sdk.ErrUnknownRequest("unrecognized message type: "+msgRoute).Result())
 			} else {
-				msgResults.Store(msgIndex, handler(ctx, msg, mode == runTxModeCheck, txIndex, msgIndex))
+				simulate := mode == runTxModeCheck
+				if isDeploy {
+					// deploys of simulated txs are executed on the committed state as well, not in a candidate block
+					simulate = mode != runTxModeDeliver
+				}
+				msgResults.Store(msgIndex, handler(ctx, msg, simulate, txIndex, msgIndex))
 			}
 
-			if msgRoute != executionlayer.ModuleName {
+			if !isDeploy {
 				currentMsgIndex++
 				msgCond.L.Unlock()
 				msgCond.Broadcast()
@@ -842,6 +852,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode, txI
 
 		msgEvents := msgResult.(sdk.Result).Events
 
+		// the deploy cost is charged even if the message fails
+		if _, isDeploy := msgs[i].(executionlayer.DeployMsg); isDeploy {
+			engineGasUsed += msgResult.(sdk.Result).GasUsed
+		}
+
 		// append events from the message's execution and a message action event
 		msgEvents = msgEvents.AppendEvent(
 			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msgs[i].Type())),
@@ -870,7 +885,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode, txI
 		Events:    events,
 	}
 
-	return result
+	return result, engineGasUsed
 }
 
 // Returns the applications's deliverState if app is in runTxModeDeliver,
@@ -914,6 +929,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx, index int)
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
+	// NOTE: The gas cost of the execution engine is not metered by the GasMeter.
+	// It is added to GasUsed as reported by the messages executed as deploys.
+	var engineGasUsed uint64
+
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
@@ -943,7 +962,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx, index int)
 		}
 
 		result.GasWanted = gasWanted
-		result.GasUsed = ctx.GasMeter().GasConsumed()
+		result.GasUsed = ctx.GasMeter().GasConsumed() + engineGasUsed
 	}()
 
 	// If BlockGasMeter() panics it will be caught by the above recover and will
@@ -1019,7 +1038,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx, index int)
 	// Create a new context based off of the existing context with a cache wrapped
 	// multi-store in case message processing fails.
 	runMsgCtx, msCache := app.cacheTxContext(ctx, txBytes)
-	result = app.runMsgs(runMsgCtx, msgs, mode, index)
+	result, engineGasUsed = app.runMsgs(runMsgCtx, msgs, mode, index)
 	result.GasWanted = gasWanted
 
 	// Safety check: don't write the cache state unless we're in DeliverTx.
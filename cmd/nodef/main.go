@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 
 	"github.com/spf13/cobra"
@@ -28,7 +29,15 @@ import (
 )
 
 // nodef custom flags
-const flagInvCheckPeriod = "inv-check-period"
+const (
+	flagInvCheckPeriod    = "inv-check-period"
+	flagEEAddress         = "ee-address"
+	flagEEDialTimeout     = "ee-dial-timeout"
+	flagEECallTimeout     = "ee-call-timeout"
+	flagEEMaxRetries      = "ee-max-retries"
+	flagEERetryBackoff    = "ee-retry-backoff"
+	flagEEMaxRetryBackoff = "ee-max-retry-backoff"
+)
 
 var invCheckPeriod uint
 
@@ -68,15 +77,37 @@ func main() {
 	executor := cli.PrepareBaseCmd(rootCmd, "GA", app.DefaultNodeHome)
 	rootCmd.PersistentFlags().UintVar(&invCheckPeriod, flagInvCheckPeriod,
 		0, "Assert registered invariants every N blocks")
+	addEngineFlags(rootCmd)
 	err := executor.Execute()
 	if err != nil {
 		panic(err)
 	}
 }
 
+// addEngineFlags adds the execution engine connection flags, which override app.toml
+func addEngineFlags(cmd *cobra.Command) {
+	defaultConfig := executionlayer.DefaultEngineConfig()
+	cmd.PersistentFlags().String(flagEEAddress, defaultConfig.Address,
+		fmt.Sprintf("Execution engine address: socket path, unix://<path>, tcp://<host>:<port> or %q for the in-process mock engine", executionlayer.MockEngineAddress))
+	cmd.PersistentFlags().Duration(flagEEDialTimeout, defaultConfig.DialTimeout, "Timeout of a connection attempt to the execution engine")
+	cmd.PersistentFlags().Duration(flagEECallTimeout, defaultConfig.CallTimeout, "Deadline of a single execution engine call (0 for no deadline)")
+	cmd.PersistentFlags().Int(flagEEMaxRetries, defaultConfig.MaxRetries, "Retries while the execution engine is unavailable (0 to retry until it is back)")
+	cmd.PersistentFlags().Duration(flagEERetryBackoff, defaultConfig.RetryBackoff, "Initial delay between retries to the execution engine")
+	cmd.PersistentFlags().Duration(flagEEMaxRetryBackoff, defaultConfig.MaxRetryBackoff, "Maximum delay between retries to the execution engine")
+}
+
+// engineConfig reads the execution engine configuration from the flags and app.toml
+func engineConfig() executionlayer.EngineConfig {
+	config := executionlayer.DefaultEngineConfig()
+	if err := viper.Unmarshal(&config); err != nil {
+		panic(err)
+	}
+	return config
+}
+
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
 	return app.NewFridayApp(
-		logger, db, traceStore, true, invCheckPeriod,
+		logger, db, traceStore, true, invCheckPeriod, engineConfig(),
 		baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))),
 		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
 		baseapp.SetHaltHeight(uint64(viper.GetInt(server.FlagHaltHeight))),
@@ -88,13 +119,13 @@ func exportAppStateAndTMValidators(
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
 
 	if height != -1 {
-		gApp := app.NewFridayApp(logger, db, traceStore, false, uint(1))
+		gApp := app.NewFridayApp(logger, db, traceStore, false, uint(1), engineConfig())
 		err := gApp.LoadHeight(height)
 		if err != nil {
 			return nil, nil, err
 		}
 		return gApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 	}
-	gApp := app.NewFridayApp(logger, db, traceStore, true, uint(1))
+	gApp := app.NewFridayApp(logger, db, traceStore, true, uint(1), engineConfig())
 	return gApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 }
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	sdk "github.com/hdac-io/friday/types"
+	eltypes "github.com/hdac-io/friday/x/executionlayer/types"
 )
 
 const (
@@ -37,6 +38,9 @@ type BaseConfig struct {
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	// ExecutionEngine defines the connection to the execution engine
+	ExecutionEngine eltypes.EngineConfig `mapstructure:",squash"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -72,5 +76,6 @@ func DefaultConfig() *Config {
 		BaseConfig{
 			MinGasPrices: defaultMinGasPrices,
 		},
+		eltypes.DefaultEngineConfig(),
 	}
 }
@@ -1,8 +1,13 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/hdac-io/friday/types"
@@ -18,3 +23,24 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestExecutionEngineConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := DefaultConfig()
+	cfg.ExecutionEngine.Address = "tcp://127.0.0.1:40401"
+	cfg.ExecutionEngine.CallTimeout = 10 * time.Second
+	cfg.ExecutionEngine.MaxRetries = 3
+	WriteConfigFile(filepath.Join(dir, "app.toml"), cfg)
+
+	viper.Reset()
+	defer viper.Reset()
+	viper.SetConfigFile(filepath.Join(dir, "app.toml"))
+	require.NoError(t, viper.ReadInConfig())
+
+	parsed, err := ParseConfig()
+	require.NoError(t, err)
+	require.Equal(t, cfg.ExecutionEngine, parsed.ExecutionEngine)
+}
@@ -32,6 +32,27 @@ halt-height = {{ .BaseConfig.HaltHeight }}
 # Note: State will not be committed on the corresponding height and any logs
 # indicating such can be safely ignored.
 halt-time = {{ .BaseConfig.HaltTime }}
+
+##### execution engine config options #####
+
+# Address of the execution engine. It is a unix socket path, unix://<path>,
+# tcp://<host>:<port> or "mock" for the in-process mock engine.
+ee-address = "{{ .ExecutionEngine.Address }}"
+
+# Timeout of a single connection attempt to the execution engine.
+ee-dial-timeout = "{{ .ExecutionEngine.DialTimeout }}"
+
+# Deadline of a single execution engine call. "0s" means no deadline.
+ee-call-timeout = "{{ .ExecutionEngine.CallTimeout }}"
+
+# Number of retries while the execution engine is unavailable. 0 retries until
+# the engine is back, which stalls block processing instead of halting the node.
+ee-max-retries = {{ .ExecutionEngine.MaxRetries }}
+
+# The delay between retries starts from ee-retry-backoff and doubles on every
+# retry up to ee-max-retry-backoff.
+ee-retry-backoff = "{{ .ExecutionEngine.RetryBackoff }}"
+ee-max-retry-backoff = "{{ .ExecutionEngine.MaxRetryBackoff }}"
 `
 
 var configTemplate *template.Template
@@ -143,6 +143,8 @@ func NewSimApp(
 	distrSubspace := app.paramsKeeper.Subspace(distr.DefaultParamspace)
 	slashingSubspace := app.paramsKeeper.Subspace(slashing.DefaultParamspace)
 	govSubspace := app.paramsKeeper.Subspace(gov.DefaultParamspace)
+	executionLayerSubspace := app.paramsKeeper.Subspace(executionlayer.DefaultParamspace)
+	nicknameSubspace := app.paramsKeeper.Subspace(nickname.DefaultParamspace)
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
@@ -156,15 +158,21 @@ func NewSimApp(
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, keys[slashing.StoreKey], &stakingKeeper,
 		slashingSubspace, slashing.DefaultCodespace)
 	// TODO - Need to change default value(socket path, protocol version)
-	app.nicknameKeeper = nickname.NewNicknameKeeper(keys[nickname.StoreKey], app.cdc, app.accountKeeper)
+	app.nicknameKeeper = nickname.NewNicknameKeeper(keys[nickname.StoreKey], app.cdc, nicknameSubspace, app.accountKeeper)
 	app.executionLayerKeeper = executionlayer.NewExecutionLayerKeeper(
 		app.cdc,
 		keys[executionlayer.HashMapStoreKey],
-		os.ExpandEnv("$HOME/.casperlabs/.casper-node.sock"),
+		executionLayerSubspace,
+		executionlayer.NewEngineConfig(executionlayer.MockEngineAddress),
+		app.Logger(),
 		app.accountKeeper,
 		app.nicknameKeeper,
 	)
 
+	// NOTE: the nickname keeper pays the prices of the names by the deploys of the execution layer keeper,
+	// which depends on the nickname keeper, so it is set after both are created
+	app.nicknameKeeper.SetDeployKeeper(app.executionLayerKeeper)
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
@@ -199,7 +207,7 @@ func NewSimApp(
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(executionlayer.ModuleName)
+	app.mm.SetOrderBeginBlockers(executionlayer.ModuleName, nickname.ModuleName)
 
 	app.mm.SetOrderEndBlockers(executionlayer.ModuleName)
 
@@ -19,13 +19,41 @@ type CandidateBlock struct {
 	AnteCond        *sync.Cond             `json:"anti_condition"`
 	CurrentTxIndex  int                    `json:"current_tx_index"`
 	NewAccounts     *queue.PriorityQueue   `json:"new_accounts"`
+	DeploySize      uint64                 `json:"deploy_size"`
+	DeployCost      uint64                 `json:"deploy_cost"`
+	Reservations    map[string]bool        `json:"reservations"`
+}
+
+// Reserve reserves the key for the rest of the candidate block, and returns false if it is reserved already.
+// The ante handlers reserve keys in the order of the block, so the deploys of the block do not take the same key.
+func (b *CandidateBlock) Reserve(key string) bool {
+	if b.Reservations == nil {
+		b.Reservations = map[string]bool{}
+	}
+	if b.Reservations[key] {
+		return false
+	}
+	b.Reservations[key] = true
+	return true
+}
+
+// IsReserved returns whether the key is reserved in the candidate block
+func (b *CandidateBlock) IsReserved(key string) bool {
+	return b.Reservations[key]
 }
 
 type ItemDeploy struct {
-	TxIndex    int             `json:"tx_index"`
-	MsgIndex   int             `json:"msg_index"`
-	Deploy     *ipc.DeployItem `json:"deploy"`
-	LogChannel chan string     `json:"deploy_channel"`
+	TxIndex       int               `json:"tx_index"`
+	MsgIndex      int               `json:"msg_index"`
+	Deploy        *ipc.DeployItem   `json:"deploy"`
+	ResultChannel chan DeployResult `json:"deploy_channel"`
+}
+
+// DeployResult carries the response of the execution engine back to the message of the deploy.
+// Index is the position of the deploy in the executed deploys.
+type DeployResult struct {
+	Response *ipc.ExecuteResponse `json:"response"`
+	Index    int                  `json:"index"`
 }
 
 func (i ItemDeploy) Compare(src queue.Item) int {
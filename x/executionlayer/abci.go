@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/Workiva/go-datastructures/queue"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/grpc"
@@ -18,16 +19,46 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, elk ExecutionLayerKeeper) {
+	// Iterate over all the validators which *should* have signed this block
+	// store whether or not they have actually signed it and jail any
+	// which have missed too many blocks in a row (downtime jailing)
+	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
+		elk.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.SignedLastBlock)
+	}
+
 	unitHash := elk.GetUnitHashMap(ctx, req.GetHeader().Height-1)
 
 	candidateBlock := ctx.CandidateBlock()
 	candidateBlock.Hash = req.GetHash()
 	candidateBlock.State = unitHash.EEState
+	if upgrade, found := elk.GetScheduledEngineUpgrade(ctx); found && upgrade.Height <= ctx.BlockHeight() {
+		applyEngineUpgrade(ctx, elk, upgrade)
+	}
 	protocolVersion := elk.GetProtocolVersion(ctx)
 	candidateBlock.ProtocolVersion = &protocolVersion
+
+	// Dependencies only resolve within the max ttl, so older deploys are dropped
+	if maxTtl := elk.GetGenesisConf(ctx).DeployConfig.MaxTtlMillis; maxTtl > 0 {
+		elk.PruneExecutedDeploys(ctx, ctx.BlockTime().Add(-time.Duration(maxTtl)*time.Millisecond))
+	}
+
+	// Slash and tombstone the validators which signed conflicting blocks,
+	// on the state of the candidate block
+	for _, evidence := range req.ByzantineValidators {
+		switch evidence.Type {
+		case tmtypes.ABCIEvidenceTypeDuplicateVote:
+			elk.HandleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Time)
+		default:
+			elk.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
+		}
+	}
+
 	candidateBlock.TxsCount = req.Header.GetNumTxs()
 	candidateBlock.DeployPQueue = queue.NewPriorityQueue(int(candidateBlock.TxsCount), false)
 	candidateBlock.NewAccounts = queue.NewPriorityQueue(int(candidateBlock.TxsCount), false)
+	candidateBlock.DeploySize = 0
+	candidateBlock.DeployCost = 0
+	candidateBlock.Reservations = map[string]bool{}
 
 	if candidateBlock.TxsCount > 0 {
 		candidateBlock.WaitGroup = sync.WaitGroup{}
@@ -41,6 +72,48 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, elk ExecutionLaye
 	}
 }
 
+// applyEngineUpgrade upgrades the execution engine on the state of the candidate block.
+// A failed upgrade is dropped, and the chain keeps the current protocol version.
+func applyEngineUpgrade(ctx sdk.Context, k ExecutionLayerKeeper, upgrade types.EngineUpgradeProposal) {
+	k.DeleteScheduledEngineUpgrade(ctx)
+
+	upgradePoint, err := upgrade.UpgradePoint()
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("engine upgrade to protocol version %s is invalid: %s", upgrade.ProtocolVersion, err.Error()))
+		return
+	}
+
+	currentProtocolVersion := k.GetProtocolVersion(ctx)
+	res, err := k.client.Upgrade(ctx.Context(), &ipc.UpgradeRequest{
+		ParentStateHash: ctx.CandidateBlock().State,
+		UpgradePoint:    upgradePoint,
+		ProtocolVersion: &currentProtocolVersion,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if _, ok := res.GetResult().(*ipc.UpgradeResponse_Success); !ok {
+		k.Logger(ctx).Error(fmt.Sprintf("engine upgrade to protocol version %s failed: %s",
+			upgrade.ProtocolVersion, res.GetFailedDeploy().GetMessage()))
+		return
+	}
+
+	postStateHash := res.GetSuccess().GetPostStateHash()
+	ctx.CandidateBlock().State = postStateHash
+	k.SetProtocolVersion(ctx, *upgradePoint.ProtocolVersion)
+	k.SetAppliedEngineUpgrade(ctx, types.NewEngineUpgrade(ctx.BlockHeight(), *upgradePoint.ProtocolVersion, postStateHash))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEngineUpgrade,
+			sdk.NewAttribute(types.AttributeKeyProtocolVersion, upgrade.ProtocolVersion),
+			sdk.NewAttribute(types.AttributeKeyPostStateHash, hex.EncodeToString(postStateHash)),
+		),
+	)
+	k.Logger(ctx).Info(fmt.Sprintf("upgraded execution engine to protocol version %s", upgrade.ProtocolVersion))
+}
+
 func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k ExecutionLayerKeeper) []abci.ValidatorUpdate {
 	stateHash := ctx.CandidateBlock().State
 
@@ -70,35 +143,16 @@ func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k ExecutionLayerKeepe
 		}
 
 		effects := []*transforms.TransformEntry{}
-		switch resExecute.GetResult().(type) {
-		case *ipc.ExecuteResponse_Success:
-			for index, res := range resExecute.GetSuccess().GetDeployResults() {
-				switch res.GetExecutionResult().GetError().GetValue().(type) {
-				case *ipc.DeployError_GasError:
-					err = types.ErrGRpcExecuteDeployGasError(types.DefaultCodespace)
-				case *ipc.DeployError_ExecError:
-					err = types.ErrGRpcExecuteDeployExecError(types.DefaultCodespace, res.GetExecutionResult().GetError().GetExecError().GetMessage())
-				}
-
-				effects = append(effects, res.GetExecutionResult().GetEffects().GetTransformMap()...)
-				if err != nil {
-					itemDeploysList[index].(*sdk.ItemDeploy).LogChannel <- err.Error()
-				} else {
-					itemDeploysList[index].(*sdk.ItemDeploy).LogChannel <- ""
-				}
-			}
-
-		case *ipc.ExecuteResponse_MissingParent:
-			err = types.ErrGRpcExecuteMissingParent(types.DefaultCodespace, hex.EncodeToString(resExecute.GetMissingParent().GetHash()))
-			for _, itemDeploy := range itemDeploysList {
-				itemDeploy.(*sdk.ItemDeploy).LogChannel <- err.Error()
-			}
-		default:
-			err = fmt.Errorf("Unknown result : %s", resExecute.String())
-			for _, itemDeploy := range itemDeploysList {
-				itemDeploy.(*sdk.ItemDeploy).LogChannel <- err.Error()
+		for index, res := range resExecute.GetSuccess().GetDeployResults() {
+			effects = append(effects, res.GetExecutionResult().GetEffects().GetTransformMap()...)
+			// the deploys which failed the precondition are not executed, and cannot be depended on
+			if res.GetPreconditionFailure() == nil && index < len(deploys) {
+				k.SetExecutedDeploy(ctx, deploys[index].DeployHash, ctx.BlockHeight())
 			}
 		}
+		for index, itemDeploy := range itemDeploysList {
+			itemDeploy.(*sdk.ItemDeploy).ResultChannel <- sdk.DeployResult{Response: resExecute, Index: index}
+		}
 
 		// Commit
 		errGrpc := ""
@@ -154,9 +208,17 @@ func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k ExecutionLayerKeepe
 	validators := k.GetAllValidators(ctx)
 
 	if len(nextStakeInfos) > 0 {
+		// only the validators ranked in the max validators have power,
+		// and the others drop out of the validator set
+		active, _ := rankValidators(validators, nextStakeInfos, k.MaxValidators(ctx))
+		activeStakes := map[string]string{}
+		for _, validator := range active {
+			activeStakes[validator.OperatorAddress.String()] = validator.Stake
+		}
+
 		for _, validator := range validators {
 			var power string
-			stake, found := nextStakeInfos[hex.EncodeToString(validator.OperatorAddress)]
+			stake, found := activeStakes[validator.OperatorAddress.String()]
 			if found {
 				if validator.Stake == stake {
 					continue
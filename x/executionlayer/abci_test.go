@@ -0,0 +1,162 @@
+package executionlayer
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+	abci "github.com/hdac-io/tendermint/abci/types"
+	tmtypes "github.com/hdac-io/tendermint/types"
+)
+
+func TestBeginBlockerEngineUpgrade(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	stateHash := input.elk.GetUnitHashMap(input.ctx, 0).EEState
+
+	ctx := input.ctx.WithBlockHeight(1)
+	input.elk.SetScheduledEngineUpgrade(ctx, types.NewEngineUpgradeProposal("title", "description", 1, "2.0.0", nil, nil))
+	BeginBlocker(ctx, abci.RequestBeginBlock{Header: abci.Header{Height: 1}}, input.elk)
+
+	_, found := input.elk.GetScheduledEngineUpgrade(ctx)
+	require.False(t, found)
+	require.Equal(t, "2.0.0", types.ProtocolVersionString(input.elk.GetProtocolVersion(ctx)))
+	require.Equal(t, "2.0.0", types.ProtocolVersionString(*ctx.CandidateBlock().ProtocolVersion))
+	require.Equal(t, []types.EngineUpgrade{types.NewEngineUpgrade(1, input.elk.GetProtocolVersion(ctx), stateHash)},
+		input.elk.GetAppliedEngineUpgrades(ctx))
+
+	upgrade := getEvent(ctx.EventManager().Events(), types.EventTypeEngineUpgrade)
+	require.NotNil(t, upgrade)
+	require.Equal(t, "2.0.0", upgrade[types.AttributeKeyProtocolVersion])
+
+	// an upgrade on the missing state fails, and keeps the protocol version
+	ctx = input.ctx.WithBlockHeight(5)
+	input.elk.SetScheduledEngineUpgrade(ctx, types.NewEngineUpgradeProposal("title", "description", 5, "3.0.0", nil, nil))
+	BeginBlocker(ctx, abci.RequestBeginBlock{Header: abci.Header{Height: 5}}, input.elk)
+
+	_, found = input.elk.GetScheduledEngineUpgrade(ctx)
+	require.False(t, found)
+	require.Equal(t, "2.0.0", types.ProtocolVersionString(input.elk.GetProtocolVersion(ctx)))
+	require.Len(t, input.elk.GetAppliedEngineUpgrades(ctx), 1)
+}
+
+func TestBeginBlockerLiveness(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+
+	consPubKey, _ := sdk.GetConsPubKeyBech32("fridayvalconspub16jrl8jvqq98x7jjxfcm8252pwd4nv6fetpzk6nzx2ddyc3fn0p2rz4mwf44nqjtfga5k5at4xad82sjhx9r9zdfcwuc5uvt90934jjr4d4xk242909rxks28v9erv3jvwfcx2wp4fe8h54fsddu9zar5v3tyknrs8pykk2mw2p29j4n6w455c7j2d3x4ykft9akx6s24gsu8ys2nvayrykqst965z")
+	validator := types.NewValidator(GenesisAccountAddress, consPubKey, types.Description{}, "")
+	input.elk.SetValidator(input.ctx, GenesisAccountAddress, validator)
+	input.elk.SetValidatorByConsAddr(input.ctx, validator)
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 10
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.DowntimeJailDuration = time.Hour
+	input.elk.SetParams(input.ctx, params)
+
+	blockTime := time.Unix(1583712000, 0).UTC()
+	beginBlock := func(height int64, signed bool) sdk.Context {
+		ctx := input.ctx.WithBlockHeight(height).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+		BeginBlocker(ctx, abci.RequestBeginBlock{
+			Header: abci.Header{Height: height},
+			LastCommitInfo: abci.LastCommitInfo{Votes: []abci.VoteInfo{{
+				Validator:       abci.Validator{Address: consPubKey.Address(), Power: 1},
+				SignedLastBlock: signed,
+			}}},
+		}, input.elk)
+		return ctx
+	}
+
+	// the validator is not jailed within the first window, however many blocks it misses
+	for height := int64(1); height <= 11; height++ {
+		beginBlock(height, false)
+	}
+	validator, _ = input.elk.GetValidator(input.ctx, GenesisAccountAddress)
+	require.False(t, validator.Jailed)
+	info, found := input.elk.GetValidatorSigningInfo(input.ctx, validator.ConsAddress())
+	require.True(t, found)
+	require.Equal(t, int64(1), info.StartHeight)
+	require.Equal(t, int64(10), info.MissedBlocksCounter)
+
+	// past the first window, it is jailed for missing more than a half of the window
+	ctx := beginBlock(12, false)
+	validator, _ = input.elk.GetValidator(input.ctx, GenesisAccountAddress)
+	require.True(t, validator.Jailed)
+	info, _ = input.elk.GetValidatorSigningInfo(input.ctx, validator.ConsAddress())
+	require.Equal(t, blockTime.Add(time.Hour), info.JailedUntil)
+	require.Zero(t, info.MissedBlocksCounter)
+	require.Zero(t, info.IndexOffset)
+
+	jail := getEvent(ctx.EventManager().Events(), types.EventTypeJail)
+	require.NotNil(t, jail)
+	require.Equal(t, GenesisAccountAddress.String(), jail[types.AttributeKeyValidator])
+	require.Equal(t, types.AttributeValueMissingSignature, jail[types.AttributeKeyReason])
+
+	// signed blocks are not counted as missed
+	beginBlock(13, true)
+	info, _ = input.elk.GetValidatorSigningInfo(input.ctx, validator.ConsAddress())
+	require.Equal(t, int64(1), info.IndexOffset)
+	require.Zero(t, info.MissedBlocksCounter)
+}
+
+func TestBeginBlockerDoubleSign(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+
+	consPubKey, _ := sdk.GetConsPubKeyBech32("fridayvalconspub16jrl8jvqq98x7jjxfcm8252pwd4nv6fetpzk6nzx2ddyc3fn0p2rz4mwf44nqjtfga5k5at4xad82sjhx9r9zdfcwuc5uvt90934jjr4d4xk242909rxks28v9erv3jvwfcx2wp4fe8h54fsddu9zar5v3tyknrs8pykk2mw2p29j4n6w455c7j2d3x4ykft9akx6s24gsu8ys2nvayrykqst965z")
+	validator := types.NewValidator(GenesisAccountAddress, consPubKey, types.Description{}, "")
+	input.elk.SetValidator(input.ctx, GenesisAccountAddress, validator)
+	input.elk.SetValidatorByConsAddr(input.ctx, validator)
+
+	blockTime := time.Unix(1583712000, 0).UTC()
+	evidence := abci.Evidence{
+		Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+		Validator: abci.Validator{Address: consPubKey.Address(), Power: 1},
+		Height:    1,
+		Time:      blockTime,
+	}
+	beginBlock := func(height int64, evidence abci.Evidence) sdk.Context {
+		ctx := input.ctx.WithBlockHeight(height).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+		BeginBlocker(ctx, abci.RequestBeginBlock{
+			Header:              abci.Header{Height: height},
+			ByzantineValidators: []abci.Evidence{evidence},
+		}, input.elk)
+		return ctx
+	}
+
+	// too old evidence is ignored
+	old := evidence
+	old.Time = blockTime.Add(-types.DefaultMaxEvidenceAge - time.Second)
+	beginBlock(1, old)
+	validator, _ = input.elk.GetValidator(input.ctx, GenesisAccountAddress)
+	require.False(t, validator.Jailed)
+	require.Empty(t, input.elk.GetAllSlashRecords(input.ctx))
+
+	// a twentieth of the stake is slashed, and the validator is tombstoned
+	ctx := beginBlock(1, evidence)
+	require.Equal(t, "950000", queryPosStakes(ctx, input.elk)[hex.EncodeToString(GenesisAccountAddress)])
+	validator, _ = input.elk.GetValidator(input.ctx, GenesisAccountAddress)
+	require.True(t, validator.Jailed)
+	info, found := input.elk.GetValidatorSigningInfo(input.ctx, validator.ConsAddress())
+	require.True(t, found)
+	require.True(t, info.Tombstoned)
+	require.Equal(t, DoubleSignJailEndTime, info.JailedUntil)
+
+	records := input.elk.GetSlashRecordsByValidator(input.ctx, GenesisAccountAddress)
+	require.Equal(t, types.SlashRecords{types.NewSlashRecord(GenesisAccountAddress, validator.ConsAddress(), 1, 1,
+		blockTime, types.DefaultSlashFractionDoubleSign, "50000", types.AttributeValueDoubleSign)}, records)
+
+	slash := getEvent(ctx.EventManager().Events(), types.EventTypeSlash)
+	require.NotNil(t, slash)
+	require.Equal(t, "50000", slash[types.AttributeKeyAmount])
+	require.Equal(t, types.AttributeValueDoubleSign, slash[types.AttributeKeyReason])
+
+	// a tombstoned validator is not slashed again
+	ctx = beginBlock(2, evidence)
+	require.Nil(t, getEvent(ctx.EventManager().Events(), types.EventTypeSlash))
+	require.Len(t, input.elk.GetAllSlashRecords(input.ctx), 1)
+}
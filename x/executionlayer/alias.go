@@ -8,16 +8,104 @@ const (
 	ModuleName      = types.ModuleName
 	RouterKey       = types.RouterKey
 	HashMapStoreKey = types.HashMapStoreKey
+
+	DefaultParamspace = types.DefaultParamspace
+
+	MockEngineAddress = types.MockEngineAddress
+	MaxStateDepth     = types.MaxStateDepth
+
+	DefaultCodespace                         = types.DefaultCodespace
+	CodeInvalidClaim                         = types.CodeInvalidClaim
+	CodeInvalidCommissionRate                = types.CodeInvalidCommissionRate
+	CodeGRpcExecuteMissingParent             = types.CodeGRpcExecuteMissingParent
+	CodeGRpcExecuteDeployGasError            = types.CodeGRpcExecuteDeployGasError
+	CodeGRpcExecuteDeployExecError           = types.CodeGRpcExecuteDeployExecError
+	CodeGRpcExecuteDeployPreconditionFailure = types.CodeGRpcExecuteDeployPreconditionFailure
+	CodeGRpcExecuteUnknownResult             = types.CodeGRpcExecuteUnknownResult
+	CodeGRpcExecuteFailure                   = types.CodeGRpcExecuteFailure
+	CodeGRpcQueryFailure                     = types.CodeGRpcQueryFailure
+	CodeEEStatePruned                        = types.CodeEEStatePruned
+	CodeInvalidContractName                  = types.CodeInvalidContractName
+	CodeContractNameExists                   = types.CodeContractNameExists
+	CodeContractNotStored                    = types.CodeContractNotStored
+	CodeContractNotFound                     = types.CodeContractNotFound
+	CodeInvalidUpgradeHeight                 = types.CodeInvalidUpgradeHeight
+	CodeInvalidUpgradeProtocolVersion        = types.CodeInvalidUpgradeProtocolVersion
+	CodeInvalidWasmCosts                     = types.CodeInvalidWasmCosts
+	CodeValidatorJailed                      = types.CodeValidatorJailed
+	CodeValidatorNotJailed                   = types.CodeValidatorNotJailed
+	CodeValidatorJailedFor                   = types.CodeValidatorJailedFor
+	CodeNoSigningInfoFound                   = types.CodeNoSigningInfoFound
+	CodeValidatorTombstoned                  = types.CodeValidatorTombstoned
+	CodeFeeTooLow                            = types.CodeFeeTooLow
+	CodeDeployTooLarge                       = types.CodeDeployTooLarge
+	CodeSessionArgsTooLong                   = types.CodeSessionArgsTooLong
+	CodeProxyMethodUnsupported               = types.CodeProxyMethodUnsupported
+	CodeInvalidDeployHeader                  = types.CodeInvalidDeployHeader
+	CodeDeployExpired                        = types.CodeDeployExpired
+	CodeDependencyNotExecuted                = types.CodeDependencyNotExecuted
+	CodeBlockDeploySizeExceeded              = types.CodeBlockDeploySizeExceeded
+	CodeBlockDeployCostExceeded              = types.CodeBlockDeployCostExceeded
+	CodeMempoolCostExceeded                  = types.CodeMempoolCostExceeded
+	CodeDeployReserved                       = types.CodeDeployReserved
+
+	MaxContractNameLength     = types.MaxContractNameLength
+	MaxContractMetadataLength = types.MaxContractMetadataLength
+
+	ProposalTypeEngineUpgrade = types.ProposalTypeEngineUpgrade
 )
 
 var (
 	// function aliases
-	NewMsgExecute  = types.NewMsgExecute
-	NewMsgTransfer = types.NewMsgTransfer
-	NewMsgBond     = types.NewMsgBond
-	NewMsgUnBond   = types.NewMsgUnBond
-	RegisterCodec  = types.RegisterCodec
-	NewUnitHashMap = types.NewUnitHashMap
+	NewMsgExecute             = types.NewMsgExecute
+	NewMsgTransfer            = types.NewMsgTransfer
+	NewMsgMultiTransfer       = types.NewMsgMultiTransfer
+	NewTransferOutput         = types.NewTransferOutput
+	NewMsgAddAssociatedKey    = types.NewMsgAddAssociatedKey
+	NewMsgRemoveAssociatedKey = types.NewMsgRemoveAssociatedKey
+	NewMsgSetActionThresholds = types.NewMsgSetActionThresholds
+	NewMsgBond                = types.NewMsgBond
+	NewMsgUnBond              = types.NewMsgUnBond
+	RegisterCodec             = types.RegisterCodec
+	NewUnitHashMap            = types.NewUnitHashMap
+
+	NewMsgDeployContract    = types.NewMsgDeployContract
+	NewRegisteredContract   = types.NewRegisteredContract
+	ValidateContractName    = types.ValidateContractName
+	NewQueryContractParams  = types.NewQueryContractParams
+	NewQueryContractsParams = types.NewQueryContractsParams
+
+	NewEngineUpgradeProposal = types.NewEngineUpgradeProposal
+	NewEngineUpgrade         = types.NewEngineUpgrade
+	ProtocolVersionString    = types.ProtocolVersionString
+
+	NewMsgJail              = types.NewMsgJail
+	NewMsgUnjail            = types.NewMsgUnjail
+	NewParams               = types.NewParams
+	DefaultParams           = types.DefaultParams
+	ParamKeyTable           = types.ParamKeyTable
+	NewValidatorSigningInfo = types.NewValidatorSigningInfo
+	NewSlashRecord          = types.NewSlashRecord
+	NewValidatorSet         = types.NewValidatorSet
+	NewDeployHeader         = types.NewDeployHeader
+	EstimatedCost           = types.EstimatedCost
+
+	NewEngineConfig     = types.NewEngineConfig
+	DefaultEngineConfig = types.DefaultEngineConfig
+
+	NewDeployResult     = types.NewDeployResult
+	DecodeDeployResults = types.DecodeDeployResults
+	NewSimulateResult   = types.NewSimulateResult
+	SuggestedFee        = types.SuggestedFee
+
+	NewDeployArgs          = types.NewDeployArgs
+	NewMethodArgs          = types.NewMethodArgs
+	DecodeDeployArgs       = types.DecodeDeployArgs
+	DeployArgsFromJSON     = types.DeployArgsFromJSON
+	ReplaceFromBech32ToHex = types.ReplaceFromBech32ToHex
+
+	NewStoredState  = types.NewStoredState
+	NewCLValueState = types.NewCLValueState
 
 	// variable aliases
 	ModuleCdc               = types.ModuleCdc
@@ -28,16 +116,86 @@ var (
 	ErrValidatorOwnerExists            = types.ErrValidatorOwnerExists
 	ErrValidatorPubKeyExists           = types.ErrValidatorPubKeyExists
 	ErrValidatorPubKeyTypeNotSupported = types.ErrValidatorPubKeyTypeNotSupported
+	ErrInvalidClaim                    = types.ErrInvalidClaim
+	ErrInvalidCommissionRate           = types.ErrInvalidCommissionRate
+
+	ErrGRpcExecuteMissingParent             = types.ErrGRpcExecuteMissingParent
+	ErrGRpcExecuteDeployGasError            = types.ErrGRpcExecuteDeployGasError
+	ErrGRpcExecuteDeployExecError           = types.ErrGRpcExecuteDeployExecError
+	ErrGRpcExecuteDeployPreconditionFailure = types.ErrGRpcExecuteDeployPreconditionFailure
+	ErrGRpcExecuteUnknownResult             = types.ErrGRpcExecuteUnknownResult
+	ErrGRpcExecuteFailure                   = types.ErrGRpcExecuteFailure
+	ErrGRpcQueryFailure                     = types.ErrGRpcQueryFailure
+	ErrEEStatePruned                        = types.ErrEEStatePruned
+
+	ErrInvalidContractName = types.ErrInvalidContractName
+	ErrContractNameExists  = types.ErrContractNameExists
+	ErrContractNotStored   = types.ErrContractNotStored
+	ErrContractNotFound    = types.ErrContractNotFound
+
+	ErrInvalidUpgradeHeight          = types.ErrInvalidUpgradeHeight
+	ErrInvalidUpgradeProtocolVersion = types.ErrInvalidUpgradeProtocolVersion
+	ErrInvalidWasmCosts              = types.ErrInvalidWasmCosts
+
+	ErrNoValidatorFound    = types.ErrNoValidatorFound
+	ErrValidatorJailed     = types.ErrValidatorJailed
+	ErrValidatorNotJailed  = types.ErrValidatorNotJailed
+	ErrValidatorJailedFor  = types.ErrValidatorJailedFor
+	ErrNoSigningInfoFound  = types.ErrNoSigningInfoFound
+	ErrValidatorTombstoned = types.ErrValidatorTombstoned
+
+	ErrFeeTooLow              = types.ErrFeeTooLow
+	ErrDeployTooLarge         = types.ErrDeployTooLarge
+	ErrSessionArgsTooLong     = types.ErrSessionArgsTooLong
+	ErrProxyMethodUnsupported = types.ErrProxyMethodUnsupported
+
+	ErrInvalidDeployHeader     = types.ErrInvalidDeployHeader
+	ErrDeployExpired           = types.ErrDeployExpired
+	ErrDependencyNotExecuted   = types.ErrDependencyNotExecuted
+	ErrBlockDeploySizeExceeded = types.ErrBlockDeploySizeExceeded
+	ErrBlockDeployCostExceeded = types.ErrBlockDeployCostExceeded
+	ErrMempoolCostExceeded     = types.ErrMempoolCostExceeded
+	ErrDeployReserved          = types.ErrDeployReserved
 )
 
 type (
 	MsgExecute                = types.MsgExecute
+	MsgMultiTransfer          = types.MsgMultiTransfer
+	TransferOutput            = types.TransferOutput
+	MsgAddAssociatedKey       = types.MsgAddAssociatedKey
+	MsgRemoveAssociatedKey    = types.MsgRemoveAssociatedKey
+	MsgSetActionThresholds    = types.MsgSetActionThresholds
 	MsgBond                   = types.MsgBond
 	MsgUnBond                 = types.MsgUnBond
+	MsgDeployContract         = types.MsgDeployContract
+	RegisteredContract        = types.RegisteredContract
+	RegisteredContracts       = types.RegisteredContracts
+	QueryContractParams       = types.QueryContractParams
+	QueryContractsParams      = types.QueryContractsParams
+	EngineUpgradeProposal     = types.EngineUpgradeProposal
+	EngineUpgrade             = types.EngineUpgrade
+	QueryEngineUpgradeResult  = types.QueryEngineUpgradeResult
 	MsgCreateValidator        = types.MsgCreateValidator
 	MsgEditValidator          = types.MsgEditValidator
+	MsgJail                   = types.MsgJail
+	MsgUnjail                 = types.MsgUnjail
+	Params                    = types.Params
+	ValidatorSigningInfo      = types.ValidatorSigningInfo
+	SlashRecord               = types.SlashRecord
+	SlashRecords              = types.SlashRecords
+	ValidatorSet              = types.ValidatorSet
+	DeployHeader              = types.DeployHeader
+	DeployMsg                 = types.DeployMsg
+	ReservingMsg              = types.ReservingMsg
 	UnitHashMap               = types.UnitHashMap
+	EngineConfig              = types.EngineConfig
+	DeployResult              = types.DeployResult
+	DeployArgs                = types.DeployArgs
+	SimulateResult            = types.SimulateResult
+	QuerySimulateParams       = types.QuerySimulateParams
 	QueryExecutionLayerDetail = types.QueryExecutionLayerDetail
+	QueryStateParams          = types.QueryStateParams
+	StoredState               = types.StoredState
 	QueryGetBalanceDetail     = types.QueryGetBalanceDetail
 	QueryGetStakeDetail       = types.QueryGetStakeDetail
 	QueryGetVoteDetail        = types.QueryGetVoteDetail
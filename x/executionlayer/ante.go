@@ -0,0 +1,217 @@
+package executionlayer
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+)
+
+// NewAnteHandler returns an AnteHandler which runs the given ante handler,
+// and then checks the deploys of the executionlayer messages against the deploy config of the chain.
+func NewAnteHandler(k ExecutionLayerKeeper, anteHandler sdk.AnteHandler) sdk.AnteHandler {
+	return func(
+		ctx sdk.Context, tx sdk.Tx, simulate bool, txIndex int,
+	) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		newCtx, res, abort = anteHandler(ctx, tx, simulate, txIndex)
+
+		if !abort {
+			if err := checkDeploys(ctx, k, tx.GetMsgs(), simulate); err != nil {
+				gasWanted := res.GasWanted
+				res, abort = err.Result(), true
+				res.GasWanted = gasWanted
+			}
+		}
+
+		// the messages of an aborted tx are not run, so the tx releases the candidate block by itself
+		if abort && isDeliverTx(ctx, simulate) {
+			ctx.CandidateBlock().WaitGroup.Done()
+		}
+		return newCtx, res, abort
+	}
+}
+
+// isDeliverTx returns whether the tx is delivered in the candidate block
+func isDeliverTx(ctx sdk.Context, simulate bool) bool {
+	return !simulate && !ctx.IsCheckTx() && ctx.CandidateBlock().TxsCount > 0
+}
+
+// checkDeploys checks the deploys of the messages against the deploy config.
+// The deploys delivered in a block are limited by the max block size and the max block cost in total,
+// and take them in the order of the block.
+func checkDeploys(ctx sdk.Context, k ExecutionLayerKeeper, msgs []sdk.Msg, simulate bool) sdk.Error {
+	deployConfig := k.GetGenesisConf(ctx).DeployConfig
+	gasPrice := k.GasPrice(ctx)
+
+	size := uint64(0)
+	cost := sdk.ZeroUint()
+	reservations := []string{}
+	for _, msg := range msgs {
+		deployMsg, ok := msg.(types.DeployMsg)
+		if !ok {
+			continue
+		}
+
+		if method := extendedProxyMethod(msg); method != "" && !k.ExtendedProxy(ctx) {
+			return types.ErrProxyMethodUnsupported(types.DefaultCodespace, method)
+		}
+
+		if header := deployMsg.GetHeader(); header != nil {
+			if err := checkDeployHeader(ctx, k, *header, deployConfig); err != nil {
+				return err
+			}
+		}
+
+		if msgDeployContract, ok := msg.(types.MsgDeployContract); ok {
+			key := contractNameReservation(msgDeployContract.Name)
+			if err := checkContractName(ctx, k, msgDeployContract.Name, key, reservations); err != nil {
+				return err
+			}
+			reservations = append(reservations, key)
+		}
+		if reservingMsg, ok := msg.(types.ReservingMsg); ok {
+			for _, key := range reservingMsg.GetReservations() {
+				if isReserved(ctx, key, reservations) {
+					return types.ErrDeployReserved(types.DefaultCodespace, key)
+				}
+				reservations = append(reservations, key)
+			}
+		}
+
+		size += uint64(len(deployMsg.GetSignBytes()))
+		cost = cost.Add(types.EstimatedCost(deployMsg.GetFee(), gasPrice))
+	}
+
+	// the candidate block of the check state lives until the next block is committed,
+	// so its deploy cost is the cost reserved by the deploys in the mempool
+	deliverTx := isDeliverTx(ctx, simulate)
+	reserveTx := ctx.IsCheckTx() && !simulate
+	candidateBlock := ctx.CandidateBlock()
+
+	if maxSize := uint64(deployConfig.MaxBlockSizeBytes); maxSize > 0 {
+		blockSize := size
+		if deliverTx {
+			blockSize += candidateBlock.DeploySize
+		}
+		if blockSize > maxSize {
+			return types.ErrBlockDeploySizeExceeded(types.DefaultCodespace, fmt.Sprintf("%d > %d bytes", blockSize, maxSize))
+		}
+	}
+
+	if maxCost := deployConfig.MaxBlockCost; maxCost > 0 {
+		reserved := uint64(0)
+		if deliverTx || reserveTx {
+			reserved = candidateBlock.DeployCost
+		}
+		if cost.AddUint64(reserved).GT(sdk.NewUint(maxCost)) {
+			msg := fmt.Sprintf("estimated cost %s, reserved %d of %d", cost, reserved, maxCost)
+			if reserveTx && reserved > 0 {
+				return types.ErrMempoolCostExceeded(types.DefaultCodespace, msg)
+			}
+			return types.ErrBlockDeployCostExceeded(types.DefaultCodespace, msg)
+		}
+	}
+
+	if deliverTx {
+		candidateBlock.DeploySize += size
+		for _, key := range reservations {
+			candidateBlock.Reserve(key)
+		}
+	}
+	if deliverTx || reserveTx {
+		candidateBlock.DeployCost += cost.Uint64()
+	}
+	return nil
+}
+
+// extendedProxyMethod returns the method of the proxy contract called by the message
+// which the client api proxy does not implement, or an empty string
+func extendedProxyMethod(msg sdk.Msg) string {
+	switch msg := msg.(type) {
+	case types.MsgExecute:
+		return paymentMethod(msg.FeePayer)
+	case types.MsgTransfer:
+		return paymentMethod(msg.FeePayer)
+	case types.MsgMultiTransfer:
+		if method := paymentMethod(msg.FeePayer); method != "" {
+			return method
+		}
+		return types.MultiTransferMethodName
+	case types.MsgAddAssociatedKey:
+		return types.AddAssociatedKeyMethodName
+	case types.MsgRemoveAssociatedKey:
+		return types.RemoveAssociatedKeyMethodName
+	case types.MsgSetActionThresholds:
+		return types.SetActionThresholdsMethodName
+	}
+	return ""
+}
+
+// paymentMethod returns the delegated payment if the fee is paid by a fee payer
+func paymentMethod(feePayer sdk.AccAddress) string {
+	if feePayer.Empty() {
+		return ""
+	}
+	return types.DelegatedPaymentMethodName
+}
+
+// contractNameReservation returns the key which reserves the contract name in the candidate block
+func contractNameReservation(name string) string {
+	return fmt.Sprintf("%s/contract/%s", types.ModuleName, name)
+}
+
+// checkContractName checks that the contract name is neither registered
+// nor taken by a deploy of the candidate block or of the same tx before
+func checkContractName(ctx sdk.Context, k ExecutionLayerKeeper, name string, key string, reservations []string) sdk.Error {
+	if _, found := k.GetRegisteredContract(ctx, name); found {
+		return types.ErrContractNameExists(types.DefaultCodespace, name)
+	}
+	if isReserved(ctx, key, reservations) {
+		return types.ErrContractNameExists(types.DefaultCodespace, name)
+	}
+	return nil
+}
+
+// isReserved returns whether the key is reserved in the candidate block, or by the tx itself
+func isReserved(ctx sdk.Context, key string, reservations []string) bool {
+	if ctx.CandidateBlock().IsReserved(key) {
+		return true
+	}
+	for _, reserved := range reservations {
+		if reserved == key {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDeployHeader checks the time to live and the dependencies of a deploy
+func checkDeployHeader(ctx sdk.Context, k ExecutionLayerKeeper, header types.DeployHeader, deployConfig types.DeployConfig) sdk.Error {
+	if err := header.Validate(); err != nil {
+		return types.ErrInvalidDeployHeader(types.DefaultCodespace, err.Error())
+	}
+	if header.TTLMillis > deployConfig.MaxTtlMillis {
+		return types.ErrInvalidDeployHeader(types.DefaultCodespace,
+			fmt.Sprintf("ttl %d ms exceeds the max ttl %d ms", header.TTLMillis, deployConfig.MaxTtlMillis))
+	}
+	if len(header.Dependencies) > int(deployConfig.MaxDependencies) {
+		return types.ErrInvalidDeployHeader(types.DefaultCodespace,
+			fmt.Sprintf("%d dependencies exceed the max dependencies %d", len(header.Dependencies), deployConfig.MaxDependencies))
+	}
+	if header.Expired(ctx.BlockTime()) {
+		return types.ErrDeployExpired(types.DefaultCodespace,
+			fmt.Sprintf("timestamp %d, ttl %d ms", header.Timestamp, header.TTLMillis))
+	}
+
+	for _, dependency := range header.Dependencies {
+		deployHash, err := hex.DecodeString(dependency)
+		if err != nil {
+			return types.ErrInvalidDeployHeader(types.DefaultCodespace, err.Error())
+		}
+		if _, found := k.GetExecutedDeploy(ctx, deployHash); !found {
+			return types.ErrDependencyNotExecuted(types.DefaultCodespace, dependency)
+		}
+	}
+	return nil
+}
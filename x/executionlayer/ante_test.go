@@ -0,0 +1,270 @@
+package executionlayer
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/auth"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+	"github.com/hdac-io/friday/x/nickname"
+	"github.com/stretchr/testify/require"
+)
+
+func passAnteHandler(ctx sdk.Context, tx sdk.Tx, simulate bool, txIndex int) (sdk.Context, sdk.Result, bool) {
+	return ctx, sdk.Result{}, false
+}
+
+func newDeployTx(header *types.DeployHeader) sdk.Tx {
+	msg := types.NewMsgExecute(ContractAddress, GenesisAccountAddress, util.HASH, []byte{}, "", types.BASIC_FEE)
+	msg.Header = header
+	return auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "")
+}
+
+func TestAnteHandlerDeployHeader(t *testing.T) {
+	input := setupTestInput()
+	blockTime := time.Unix(1583712000, 0).UTC()
+	ctx := input.ctx.WithBlockTime(blockTime).WithIsCheckTx(true)
+	anteHandler := NewAnteHandler(input.elk, passAnteHandler)
+
+	_, res, abort := anteHandler(ctx, newDeployTx(nil), false, 0)
+	require.False(t, abort, res.Log)
+
+	header := types.NewDeployHeader(blockTime.Add(-time.Hour), time.Hour, nil)
+	_, res, abort = anteHandler(ctx, newDeployTx(&header), false, 0)
+	require.False(t, abort, res.Log)
+
+	header = types.NewDeployHeader(blockTime.Add(-time.Hour), time.Hour-time.Millisecond, nil)
+	_, res, abort = anteHandler(ctx, newDeployTx(&header), false, 0)
+	require.True(t, abort)
+	require.Equal(t, types.CodeDeployExpired, res.Code)
+
+	maxTTL := time.Duration(input.elk.GetGenesisConf(ctx).DeployConfig.MaxTtlMillis) * time.Millisecond
+	header = types.NewDeployHeader(blockTime, maxTTL+time.Millisecond, nil)
+	_, res, abort = anteHandler(ctx, newDeployTx(&header), false, 0)
+	require.True(t, abort)
+	require.Equal(t, types.CodeInvalidDeployHeader, res.Code)
+
+	// the dependency must be executed before
+	dependency := make([]byte, 32)
+	dependency[0] = 1
+	header = types.NewDeployHeader(blockTime, 0, []string{hex.EncodeToString(dependency)})
+	_, res, abort = anteHandler(ctx, newDeployTx(&header), false, 0)
+	require.True(t, abort)
+	require.Equal(t, types.CodeDependencyNotExecuted, res.Code)
+
+	input.elk.SetExecutedDeploy(ctx, dependency, 1)
+	height, found := input.elk.GetExecutedDeploy(ctx, dependency)
+	require.True(t, found)
+	require.Equal(t, int64(1), height)
+	_, res, abort = anteHandler(ctx, newDeployTx(&header), false, 0)
+	require.False(t, abort, res.Log)
+
+	// the header of any deploy is checked
+	expired := types.NewDeployHeader(blockTime.Add(-time.Hour), time.Minute, nil)
+	transfer := types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1", types.BASIC_FEE)
+	transfer.Header = &expired
+	_, res, abort = anteHandler(ctx, auth.NewStdTx([]sdk.Msg{transfer}, auth.StdFee{}, nil, ""), false, 0)
+	require.True(t, abort)
+	require.Equal(t, types.CodeDeployExpired, res.Code)
+
+	invalid := types.DeployHeader{}
+	transfer.Header = &invalid
+	_, res, abort = anteHandler(ctx, auth.NewStdTx([]sdk.Msg{transfer}, auth.StdFee{}, nil, ""), false, 0)
+	require.True(t, abort)
+	require.Equal(t, types.CodeInvalidDeployHeader, res.Code)
+}
+
+func TestAnteHandlerBlockLimits(t *testing.T) {
+	input := setupTestInput()
+	anteHandler := NewAnteHandler(input.elk, passAnteHandler)
+
+	txSize := uint64(len(newDeployTx(nil).GetMsgs()[0].GetSignBytes()))
+	txCost := types.EstimatedCost(types.BASIC_FEE, types.DefaultGasPrice).Uint64()
+	genesisConf := input.elk.GetGenesisConf(input.ctx)
+	genesisConf.DeployConfig.MaxBlockSizeBytes = uint32(2 * txSize)
+	genesisConf.DeployConfig.MaxBlockCost = 3 * txCost
+	input.elk.SetGenesisConf(input.ctx, genesisConf)
+
+	candidateBlock := &sdk.CandidateBlock{TxsCount: 4}
+	candidateBlock.WaitGroup.Add(4)
+	ctx := input.ctx.WithCandidateBlock(candidateBlock)
+
+	for i := 0; i < 2; i++ {
+		_, res, abort := anteHandler(ctx, newDeployTx(nil), false, i)
+		require.False(t, abort, res.Log)
+	}
+	require.Equal(t, 2*txSize, candidateBlock.DeploySize)
+	require.Equal(t, 2*txCost, candidateBlock.DeployCost)
+
+	// the deploys of a block are limited in total
+	_, res, abort := anteHandler(ctx, newDeployTx(nil), false, 2)
+	require.True(t, abort)
+	require.Equal(t, types.CodeBlockDeploySizeExceeded, res.Code)
+	require.Equal(t, 2*txSize, candidateBlock.DeploySize)
+
+	genesisConf.DeployConfig.MaxBlockSizeBytes = 0
+	input.elk.SetGenesisConf(input.ctx, genesisConf)
+	_, res, abort = anteHandler(ctx, newDeployTx(nil), false, 2)
+	require.False(t, abort, res.Log)
+	_, res, abort = anteHandler(ctx, newDeployTx(nil), false, 3)
+	require.True(t, abort)
+	require.Equal(t, types.CodeBlockDeployCostExceeded, res.Code)
+	require.Equal(t, 3*txCost, candidateBlock.DeployCost)
+}
+
+func TestAnteHandlerMempoolReserve(t *testing.T) {
+	input := setupTestInput()
+	anteHandler := NewAnteHandler(input.elk, passAnteHandler)
+
+	txCost := types.EstimatedCost(types.BASIC_FEE, types.DefaultGasPrice).Uint64()
+	genesisConf := input.elk.GetGenesisConf(input.ctx)
+	genesisConf.DeployConfig.MaxBlockCost = 2 * txCost
+	input.elk.SetGenesisConf(input.ctx, genesisConf)
+
+	candidateBlock := &sdk.CandidateBlock{}
+	ctx := input.ctx.WithIsCheckTx(true).WithCandidateBlock(candidateBlock)
+
+	// a deploy over the max block cost by itself never fits in a block
+	msg := types.NewMsgExecute(ContractAddress, GenesisAccountAddress, util.HASH, []byte{}, "", "30000000000000000")
+	_, res, abort := anteHandler(ctx, auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, ""), false, 0)
+	require.True(t, abort)
+	require.Equal(t, types.CodeBlockDeployCostExceeded, res.Code)
+	require.Zero(t, candidateBlock.DeployCost)
+
+	// the deploys in the mempool reserve the cost of the next block
+	for i := 0; i < 2; i++ {
+		_, res, abort = anteHandler(ctx, newDeployTx(nil), false, 0)
+		require.False(t, abort, res.Log)
+	}
+	require.Equal(t, 2*txCost, candidateBlock.DeployCost)
+
+	// simulations do not reserve the cost
+	_, res, abort = anteHandler(ctx, newDeployTx(nil), true, 0)
+	require.False(t, abort, res.Log)
+
+	_, res, abort = anteHandler(ctx, newDeployTx(nil), false, 0)
+	require.True(t, abort)
+	require.Equal(t, types.CodeMempoolCostExceeded, res.Code)
+}
+
+func TestAnteHandlerContractName(t *testing.T) {
+	input := setupTestInput()
+	anteHandler := NewAnteHandler(input.elk, passAnteHandler)
+
+	candidateBlock := &sdk.CandidateBlock{TxsCount: 4}
+	candidateBlock.WaitGroup.Add(4)
+	ctx := input.ctx.WithCandidateBlock(candidateBlock)
+
+	newTx := func(name string) sdk.Tx {
+		msg := types.NewMsgDeployContract(GenesisAccountAddress, name, []byte{0x00, 0x61, 0x73, 0x6d}, "", "", types.BASIC_FEE)
+		return auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "")
+	}
+
+	// the first deploy of the block reserves the name
+	_, res, abort := anteHandler(ctx, newTx("counter"), false, 0)
+	require.False(t, abort, res.Log)
+	require.True(t, candidateBlock.IsReserved(contractNameReservation("counter")))
+
+	_, res, abort = anteHandler(ctx, newTx("counter"), false, 1)
+	require.True(t, abort)
+	require.Equal(t, types.CodeContractNameExists, res.Code)
+
+	// the names of a tx are reserved together
+	msg := types.NewMsgDeployContract(GenesisAccountAddress, "counter2", []byte{0x00, 0x61, 0x73, 0x6d}, "", "", types.BASIC_FEE)
+	tx := auth.NewStdTx([]sdk.Msg{msg, msg}, auth.StdFee{}, nil, "")
+	_, res, abort = anteHandler(ctx, tx, false, 2)
+	require.True(t, abort)
+	require.Equal(t, types.CodeContractNameExists, res.Code)
+	require.False(t, candidateBlock.IsReserved(contractNameReservation("counter2")))
+
+	input.elk.SetRegisteredContract(ctx, types.NewRegisteredContract("counter3", RecipientAccountAddress, "", "", 1, ""))
+	_, res, abort = anteHandler(ctx, newTx("counter3"), false, 3)
+	require.True(t, abort)
+	require.Equal(t, types.CodeContractNameExists, res.Code)
+}
+
+func TestAnteHandlerExtendedProxy(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithIsCheckTx(true)
+	anteHandler := NewAnteHandler(input.elk, passAnteHandler)
+
+	outputs := []types.TransferOutput{types.NewTransferOutput(RecipientAccountAddress, "1000")}
+	msg := types.NewMsgMultiTransfer(ContractAddress, GenesisAccountAddress, outputs, types.BASIC_FEE)
+	tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "")
+
+	// the client api proxy does not implement the multi transfer
+	_, res, abort := anteHandler(ctx, tx, false, 0)
+	require.True(t, abort)
+	require.Equal(t, types.CodeProxyMethodUnsupported, res.Code)
+
+	// neither the delegated payment of a fee payer
+	transfer := types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000", types.BASIC_FEE)
+	_, res, abort = anteHandler(ctx, auth.NewStdTx([]sdk.Msg{transfer}, auth.StdFee{}, nil, ""), false, 0)
+	require.False(t, abort, res.Log)
+	transfer.FeePayer = RecipientAccountAddress
+	feePayerTx := auth.NewStdTx([]sdk.Msg{transfer}, auth.StdFee{}, nil, "")
+	_, res, abort = anteHandler(ctx, feePayerTx, false, 0)
+	require.True(t, abort)
+	require.Equal(t, types.CodeProxyMethodUnsupported, res.Code)
+
+	params := types.DefaultParams()
+	params.ExtendedProxy = true
+	input.elk.SetParams(ctx, params)
+	_, res, abort = anteHandler(ctx, tx, false, 0)
+	require.False(t, abort, res.Log)
+	_, res, abort = anteHandler(ctx, feePayerTx, false, 0)
+	require.False(t, abort, res.Log)
+}
+
+func TestAnteHandlerAssociatedKeysExtendedProxy(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithIsCheckTx(true)
+	anteHandler := NewAnteHandler(input.elk, passAnteHandler)
+
+	msgs := []sdk.Msg{
+		types.NewMsgAddAssociatedKey(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, 1, types.BASIC_FEE, nil),
+		types.NewMsgRemoveAssociatedKey(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, types.BASIC_FEE, nil),
+		types.NewMsgSetActionThresholds(ContractAddress, GenesisAccountAddress, 1, 1, types.BASIC_FEE, nil),
+	}
+	for _, msg := range msgs {
+		_, res, abort := anteHandler(ctx, auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, ""), false, 0)
+		require.True(t, abort)
+		require.Equal(t, types.CodeProxyMethodUnsupported, res.Code)
+	}
+
+	params := types.DefaultParams()
+	params.ExtendedProxy = true
+	input.elk.SetParams(ctx, params)
+	for _, msg := range msgs {
+		_, res, abort := anteHandler(ctx, auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, ""), false, 0)
+		require.False(t, abort, res.Log)
+	}
+}
+
+func TestAnteHandlerReservations(t *testing.T) {
+	input := setupTestInput()
+	anteHandler := NewAnteHandler(input.elk, passAnteHandler)
+
+	candidateBlock := &sdk.CandidateBlock{TxsCount: 3}
+	candidateBlock.WaitGroup.Add(3)
+	ctx := input.ctx.WithCandidateBlock(candidateBlock)
+
+	register := nickname.NewMsgSetAccount(nickname.NewName("abc"), GenesisAccountAddress)
+	register.Fee = types.BASIC_FEE
+	_, res, abort := anteHandler(ctx, auth.NewStdTx([]sdk.Msg{register}, auth.StdFee{}, nil, ""), false, 0)
+	require.False(t, abort, res.Log)
+
+	// another tx of the block can't register nor renew the name before it is set
+	register.Address = RecipientAccountAddress
+	_, res, abort = anteHandler(ctx, auth.NewStdTx([]sdk.Msg{register}, auth.StdFee{}, nil, ""), false, 1)
+	require.True(t, abort)
+	require.Equal(t, types.CodeDeployReserved, res.Code)
+
+	renew := nickname.NewMsgRenew("abc", GenesisAccountAddress, types.BASIC_FEE)
+	_, res, abort = anteHandler(ctx, auth.NewStdTx([]sdk.Msg{renew}, auth.StdFee{}, nil, ""), false, 2)
+	require.True(t, abort)
+	require.Equal(t, types.CodeDeployReserved, res.Code)
+}
@@ -5,6 +5,7 @@ import (
 
 	flag "github.com/spf13/pflag"
 
+	"github.com/hdac-io/friday/client"
 	"github.com/hdac-io/friday/x/executionlayer/types"
 )
 
@@ -17,11 +18,28 @@ const (
 
 	FlagBlockHash = "blockhash"
 
+	FlagDecode = "decode"
+	FlagDepth  = "depth"
+
+	FlagMetadata = "metadata"
+	FlagDeployer = "deployer"
+
+	FlagTTL          = "ttl"
+	FlagDependencies = "dependencies"
+
+	FlagFile     = "file"
+	FlagFeePayer = "fee-payer"
+
+	FlagAccount           = "account"
+	FlagAuthorizationKeys = "authorization-keys"
+
 	FlagMoniker  = "moniker"
 	FlagIdentity = "identity"
 	FlagWebsite  = "website"
 	FlagDetails  = "details"
 
+	FlagCommissionRate = "commission-rate"
+
 	FlagMinSelfDelegation = "min-self-delegation"
 
 	FlagGenesisFormat = "genesis-format"
@@ -35,7 +53,12 @@ var (
 	FsAmount            = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionCreate = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionEdit   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCommissionCreate  = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCommissionEdit    = flag.NewFlagSet("", flag.ContinueOnError)
 	fsValidator         = flag.NewFlagSet("", flag.ContinueOnError)
+	fsSimulate          = flag.NewFlagSet("", flag.ContinueOnError)
+	fsFeePayer          = flag.NewFlagSet("", flag.ContinueOnError)
+	fsAuthorization     = flag.NewFlagSet("", flag.ContinueOnError)
 
 	DefaultClientHome = os.ExpandEnv("$HOME/.clif")
 )
@@ -50,5 +73,11 @@ func init() {
 	fsDescriptionEdit.String(FlagIdentity, types.DoNotModifyDesc, "The (optional) identity signature (ex. UPort or Keybase)")
 	fsDescriptionEdit.String(FlagWebsite, types.DoNotModifyDesc, "The validator's (optional) website")
 	fsDescriptionEdit.String(FlagDetails, types.DoNotModifyDesc, "The validator's (optional) details")
+	fsCommissionCreate.String(FlagCommissionRate, "0", "The validator's commission rate, within the bounds of the params")
+	fsCommissionEdit.String(FlagCommissionRate, "", "The validator's new commission rate, within the bounds of the params")
 	fsValidator.String(FlagAddressValidator, "", "The Bech32 address of the validator")
+	fsFeePayer.String(FlagFeePayer, "", "Fee payer's address or nickname, who pays the fee and co-signs the tx")
+	fsAuthorization.String(FlagAccount, "", "Multi-key account's address or nickname, which the sender acts for as its associated key. The account co-signs the tx")
+	fsAuthorization.StringSlice(FlagAuthorizationKeys, nil, "Associated keys' addresses or nicknames, which co-sign the tx to authorize the deploy of the account")
+	fsSimulate.Bool(client.FlagDryRun, false, "Simulate the transaction on the execution engine and print its gas cost and suggested fee, but don't broadcast it")
 }
@@ -70,8 +70,7 @@ func GetCmdQueryBalance(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querybalancedetail", types.ModuleName), bz)
 			if err != nil {
-				fmt.Printf("no balance data of input")
-				return nil
+				return fmt.Errorf("no balance data of input: %s", err)
 			}
 			out := &state.Value{}
 			err = jsonpb.Unmarshal(bytes.NewReader(res), out)
@@ -126,8 +125,7 @@ func GetCmdQueryStake(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querystakedetail", types.ModuleName), bz)
 			if err != nil {
-				fmt.Printf("no stake data of input")
-				return nil
+				return fmt.Errorf("no stake data of input: %s", err)
 			}
 			out := &state.Value{}
 			err = jsonpb.Unmarshal(bytes.NewReader(res), out)
@@ -186,8 +184,7 @@ func GetCmdQueryVote(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/queryvotedetail", types.ModuleName), bz)
 			if err != nil {
-				fmt.Printf("no vote dapp data of input")
-				return nil
+				return fmt.Errorf("no vote dapp data of input: %s", err)
 			}
 
 			out := &state.Value{}
@@ -212,9 +209,12 @@ func GetCmdQueryVote(cdc *codec.Codec) *cobra.Command {
 // GetCmdQuery is a EE query getter
 func GetCmdQuery(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "query address|uref|hash|local <data> <path> [--height <block_height>]",
+		Use:   "query address|uref|hash|local <data> <path> [--decode] [--depth <depth>] [--height <block_height>]",
 		Short: "Get query of the data",
-		Args:  cobra.MaximumNArgs(3),
+		Long: `Get query of the data.
+With --decode, accounts, contracts and CLValues are decoded into JSON with their named keys,
+and the named keys are walked to --depth levels.`,
+		Args: cobra.MaximumNArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			dataType := args[0]
@@ -224,6 +224,23 @@ func GetCmdQuery(cdc *codec.Codec) *cobra.Command {
 				path = args[2]
 			}
 
+			if viper.GetBool(FlagDecode) {
+				stateParams := types.QueryStateParams{
+					KeyType: dataType,
+					KeyData: data,
+					Path:    path,
+					Depth:   viper.GetInt(FlagDepth),
+				}
+				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querystate", types.ModuleName), cdc.MustMarshalJSON(stateParams))
+				if err != nil {
+					return fmt.Errorf("could not resolve data - %s %s %s: %s", dataType, data, path, err)
+				}
+
+				var out types.StoredState
+				cdc.MustUnmarshalJSON(res, &out)
+				return cliCtx.PrintOutput(out)
+			}
+
 			queryData := types.QueryExecutionLayerDetail{
 				KeyType: dataType,
 				KeyData: data,
@@ -233,8 +250,7 @@ func GetCmdQuery(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querydetail", types.ModuleName), bz)
 			if err != nil {
-				fmt.Printf("could not resolve data - %s %s %s\nerr : %s\n", dataType, data, path, err.Error())
-				return nil
+				return fmt.Errorf("could not resolve data - %s %s %s: %s", dataType, data, path, err)
 			}
 			var storedValue storedvalue.StoredValue
 			storedValue, err, _ = storedValue.FromBytes(res)
@@ -270,14 +286,16 @@ func GetCmdQuery(cdc *codec.Codec) *cobra.Command {
 			return err
 		},
 	}
+	cmd.Flags().Bool(FlagDecode, false, "Decode the stored value into JSON")
+	cmd.Flags().Int(FlagDepth, 0, fmt.Sprintf("Levels of named keys to walk with --decode, at most %d", types.MaxStateDepth))
 	return cmd
 }
 
 // GetCmdQueryValidator implements the validator query command.
 func GetCmdQueryValidator(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "validator [--from <from>]",
-		Short: "Query a validator",
+		Use:   "validator [--from <from>] [--height <block_height>]",
+		Short: "Query a validator, or the active and candidate validator sets",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -303,13 +321,12 @@ func GetCmdQueryValidator(cdc *codec.Codec) *cobra.Command {
 			}
 
 			if addr.Empty() {
-				res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/queryallvalidator", types.ModuleName))
+				res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/queryvalidatorset", types.ModuleName))
 				if err != nil {
-					fmt.Printf("could not resolve")
-					return nil
+					return fmt.Errorf("could not resolve validators: %s", err)
 				}
 
-				var out types.Validators
+				var out types.ValidatorSet
 				cdc.MustUnmarshalJSON(res, &out)
 
 				return cliCtx.PrintOutput(out)
@@ -319,8 +336,7 @@ func GetCmdQueryValidator(cdc *codec.Codec) *cobra.Command {
 
 				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/queryvalidator", types.ModuleName), bz)
 				if err != nil {
-					fmt.Printf("could not resolve data - %s\n", addr.String())
-					return nil
+					return fmt.Errorf("could not resolve data - %s: %s", addr.String(), err)
 				}
 
 				if len(res) == 0 {
@@ -341,11 +357,59 @@ func GetCmdQueryValidator(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQuerySigningInfo implements the signing info query command.
+func GetCmdQuerySigningInfo(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info --from <from> [--height <block_height>]",
+		Short: "Query the liveness of a validator",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valueFromFromFlag := viper.GetString(client.FlagFrom)
+			addr, err := cliutil.GetAddress(cdc, cliCtx, valueFromFromFlag)
+			if err != nil {
+				kb, err := client.NewKeyBaseFromDir(viper.GetString(client.FlagHome))
+				if err != nil {
+					return err
+				}
+
+				keyInfo, err := kb.Get(valueFromFromFlag)
+				if err != nil {
+					return err
+				}
+
+				addr = keyInfo.GetAddress()
+			}
+
+			queryData := types.NewQueryValidatorParams(addr)
+			bz := cdc.MustMarshalJSON(queryData)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querysigninginfo", types.ModuleName), bz)
+			if err != nil {
+				return fmt.Errorf("could not resolve signing info - %s: %s", addr.String(), err)
+			}
+
+			var out types.ValidatorSigningInfo
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(client.FlagFrom, "", "Validator's identity (one of wallet alias, address, nickname)")
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
 // GetCmdQueryDelegator implements the validator query command.
 func GetCmdQueryDelegator(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delegator [<vaidator-address>] [--from <from>]",
-		Short: "Query a validator",
+		Use:   "delegator [<vaidator-address>] [--from <from>] [--height <block_height>]",
+		Short: "Query a validator, or the active and candidate validator sets",
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -387,8 +451,7 @@ func GetCmdQueryDelegator(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querydelegator", types.ModuleName), bz)
 			if err != nil {
-				fmt.Printf("could not resolve data - %s\n", addr.String())
-				return nil
+				return fmt.Errorf("could not resolve data - %s: %s", addr.String(), err)
 			}
 
 			if len(res) == 0 {
@@ -421,7 +484,7 @@ func GetCmdQueryDelegator(cdc *codec.Codec) *cobra.Command {
 // GetCmdQueryVoter implements the validator query command.
 func GetCmdQueryVoter(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "voter [<contract_address>] [--from <from>]",
+		Use:   "voter [<contract_address>] [--from <from>] [--height <block_height>]",
 		Short: "Query a voter",
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -482,8 +545,7 @@ func GetCmdQueryVoter(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/queryvoter", types.ModuleName), bz)
 			if err != nil {
-				fmt.Printf("could not resolve data - %s %s\n", contractAddress.String(), addr.String())
-				return nil
+				return fmt.Errorf("could not resolve data - %s %s: %s", contractAddress.String(), addr.String(), err)
 			}
 
 			if len(res) == 0 {
@@ -516,7 +578,7 @@ func GetCmdQueryVoter(cdc *codec.Codec) *cobra.Command {
 // GetCmdQueryReward is a getter of the reward of the address
 func GetCmdQueryReward(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "getreward --from <from>",
+		Use:   "getreward --from <from> [--height <block_height>]",
 		Short: "Get reward of address",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -545,8 +607,7 @@ func GetCmdQueryReward(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/queryreward", types.ModuleName), bz)
 			if err != nil {
-				fmt.Printf("no reward data of input")
-				return nil
+				return fmt.Errorf("no reward data of input: %s", err)
 			}
 			out := &state.Value{}
 			err = jsonpb.Unmarshal(bytes.NewReader(res), out)
@@ -570,7 +631,7 @@ func GetCmdQueryReward(cdc *codec.Codec) *cobra.Command {
 // GetCmdQueryCommission is a getter of the commission of the address
 func GetCmdQueryCommission(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "getcommission --from <from>",
+		Use:   "getcommission --from <from> [--height <block_height>]",
 		Short: "Get reward of address",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -599,8 +660,7 @@ func GetCmdQueryCommission(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querycommission", types.ModuleName), bz)
 			if err != nil {
-				fmt.Printf("no reward data of input")
-				return nil
+				return fmt.Errorf("no reward data of input: %s", err)
 			}
 			out := &state.Value{}
 			err = jsonpb.Unmarshal(bytes.NewReader(res), out)
@@ -620,3 +680,157 @@ func GetCmdQueryCommission(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryContract is a getter of the contract registered as the name
+func GetCmdQueryContract(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info <name> [--height <block_height>]",
+		Short: "Query a registered contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz := cdc.MustMarshalJSON(types.NewQueryContractParams(args[0]))
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querycontract", types.ModuleName), bz)
+			if err != nil {
+				return fmt.Errorf("could not resolve contract - %s: %s", args[0], err)
+			}
+
+			var out types.RegisteredContract
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryContracts is a getter of the registered contracts
+func GetCmdQueryContracts(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [--deployer <deployer>] [--height <block_height>]",
+		Short: "Query the registered contracts, of the deployer if given",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var deployer sdk.AccAddress
+			if valueFromDeployerFlag := viper.GetString(FlagDeployer); valueFromDeployerFlag != "" {
+				var err error
+				deployer, err = cliutil.GetAddress(cdc, cliCtx, valueFromDeployerFlag)
+				if err != nil {
+					return err
+				}
+			}
+
+			bz := cdc.MustMarshalJSON(types.NewQueryContractsParams(deployer))
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querycontracts", types.ModuleName), bz)
+			if err != nil {
+				return fmt.Errorf("could not resolve contracts: %s", err)
+			}
+
+			var out types.RegisteredContracts
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(FlagDeployer, "", "Deployer's address or nickname")
+
+	return cmd
+}
+
+// GetCmdQueryEngineUpgrade is a getter of the scheduled and applied engine upgrades
+func GetCmdQueryEngineUpgrade(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade [--height <block_height>]",
+		Short: "Query the protocol version, and the scheduled and applied engine upgrades",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/queryupgrade", types.ModuleName))
+			if err != nil {
+				return fmt.Errorf("could not resolve engine upgrade: %s", err)
+			}
+
+			var out types.QueryEngineUpgradeResult
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQuerySlashes implements the slash history query command.
+func GetCmdQuerySlashes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashes [--from <from>] [--height <block_height>]",
+		Short: "Query the slash history of a validator, or of every validator",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valueFromFromFlag := viper.GetString(client.FlagFrom)
+			var addr sdk.AccAddress
+			var err error
+			if valueFromFromFlag != "" {
+				addr, err = cliutil.GetAddress(cdc, cliCtx, valueFromFromFlag)
+				if err != nil {
+					kb, err := client.NewKeyBaseFromDir(viper.GetString(client.FlagHome))
+					if err != nil {
+						return err
+					}
+
+					keyInfo, err := kb.Get(valueFromFromFlag)
+					if err != nil {
+						return err
+					}
+
+					addr = keyInfo.GetAddress()
+				}
+			}
+
+			var bz []byte
+			if !addr.Empty() {
+				bz = cdc.MustMarshalJSON(types.NewQueryValidatorParams(addr))
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/queryslashes", types.ModuleName), bz)
+			if err != nil {
+				return fmt.Errorf("could not resolve slashes: %s", err)
+			}
+
+			var out types.SlashRecords
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(client.FlagFrom, "", "Validator's identity (one of wallet alias, address, nickname)")
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params [--height <block_height>]",
+		Short: "Query the current execution layer parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/queryparams", types.ModuleName))
+			if err != nil {
+				return fmt.Errorf("could not resolve params: %s", err)
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	return cmd
+}
@@ -19,6 +19,10 @@ func GetHdacCustomCmd(cdc *codec.Codec) *cobra.Command {
 	hdacCustomTxCmd.AddCommand(client.GetCommands(
 		// Tx
 		GetCmdTransfer(cdc),
+		GetCmdMultiTransfer(cdc),
+		GetCmdAddAssociatedKey(cdc),
+		GetCmdRemoveAssociatedKey(cdc),
+		GetCmdSetActionThresholds(cdc),
 		GetCmdBonding(cdc),
 		GetCmdUnbonding(cdc),
 		GetCmdDelegate(cdc),
@@ -26,6 +30,8 @@ func GetHdacCustomCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdRedelegate(cdc),
 		GetCmdCreateValidator(cdc),
 		GetCmdEditValidator(cdc),
+		GetCmdJail(cdc),
+		GetCmdUnjail(cdc),
 		GetCmdVote(cdc),
 		GetCmdUnvote(cdc),
 		GetCmdClaimReward(cdc),
@@ -35,6 +41,9 @@ func GetHdacCustomCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryStake(cdc),
 		GetCmdQueryVote(cdc),
 		GetCmdQueryValidator(cdc),
+		GetCmdQuerySigningInfo(cdc),
+		GetCmdQuerySlashes(cdc),
+		GetCmdQueryParams(cdc),
 		GetCmdQueryDelegator(cdc),
 		GetCmdQueryReward(cdc),
 		GetCmdQueryCommission(cdc),
@@ -55,6 +64,14 @@ func GetContractCmd(cdc *codec.Codec) *cobra.Command {
 		// Tx
 		GetCmdQuery(cdc),
 		GetCmdContractRun(cdc),
+		GetCmdContractDeploy(cdc),
+
+		// Registry
+		GetCmdQueryContract(cdc),
+		GetCmdQueryContracts(cdc),
+
+		// Upgrade
+		GetCmdQueryEngineUpgrade(cdc),
 	)...)
 
 	return contractTxCmd
@@ -1,9 +1,11 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
 	"github.com/hdac-io/friday/client"
@@ -13,18 +15,23 @@ import (
 
 	"github.com/hdac-io/friday/client/context"
 	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/version"
 	"github.com/hdac-io/friday/x/auth"
 	"github.com/hdac-io/friday/x/auth/client/utils"
+	govtypes "github.com/hdac-io/friday/x/gov/types"
+	idtype "github.com/hdac-io/friday/x/nickname/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 func GetCmdContractRun(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "run <type> <wasm-path>|<uref>|<name>|<hash> <argument> <fee> --from <from>",
+		Use:   "run <type> <wasm-path>|<uref>|<name>|<hash>|<dapp_nickname> <argument> <fee> --from <from> [--ttl <ttl>] [--dependencies <deploy-hash>,...] [--fee-payer <fee-payer>] [--account <account>] [--authorization-keys <key>,...]",
 		Short: "Run contract",
 		Long: "Run contract\n" +
-			"There are 4 types of contract run. ('wasm', 'uref', 'name', 'hash)",
+			"There are 4 types of contract run. ('wasm', 'uref', 'name', 'hash)\n" +
+			"The 'uref' and 'hash' types take the nickname of a dapp as well, which resolves to its contract record.\n" +
+			"The deploy expires after the ttl, and is executed only after the dependency deploys are executed.",
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -41,7 +48,10 @@ func GetCmdContractRun(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 			cliCtx = cliCtx.WithFromAddress(keyInfo.GetAddress()).WithFromName(keyInfo.GetName())
-			fromAddr := keyInfo.GetAddress()
+			fromAddr, authorizationKeys, err := GetAuthorization(cliCtx, keyInfo.GetAddress())
+			if err != nil {
+				return err
+			}
 
 			sessionType := cliutil.GetContractType(args[0])
 			var sessionCode []byte
@@ -52,18 +62,18 @@ func GetCmdContractRun(cdc *codec.Codec) *cobra.Command {
 				contractAddress = "wasm_file_direct_execution"
 				sessionCode = util.LoadWasmFile(args[1])
 			case util.HASH:
-				contractAddress = args[1]
-				contractHashAddr, err := sdk.ContractHashAddressFromBech32(args[1])
+				contractHashAddr, err := cliutil.GetContractHashAddress(cdc, cliCtx, args[1])
 				if err != nil {
 					return err
 				}
+				contractAddress = contractHashAddr.String()
 				sessionCode = contractHashAddr.Bytes()
 			case util.UREF:
-				contractAddress = args[1]
-				contractUrefAddr, err := sdk.ContractUrefAddressFromBech32(args[1])
+				contractUrefAddr, err := cliutil.GetContractUrefAddress(cdc, cliCtx, args[1])
 				if err != nil {
 					return err
 				}
+				contractAddress = contractUrefAddr.String()
 				sessionCode = contractUrefAddr.Bytes()
 			case util.NAME:
 				contractAddress = fmt.Sprintf("%s:%s", fromAddr.String(), args[1])
@@ -77,14 +87,9 @@ func GetCmdContractRun(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			prettyJSON := []byte("")
-			var jsonData []map[string]interface{}
-			if len(args[2]) > 0 {
-				err = json.Unmarshal([]byte(args[2]), &jsonData)
-				if err != nil {
-					return err
-				}
-				prettyJSON, err = json.Marshal(jsonData)
+			sessionArgs, err := cliutil.NormalizeSessionArgs(args[2])
+			if err != nil {
+				return err
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
@@ -93,16 +98,93 @@ func GetCmdContractRun(cdc *codec.Codec) *cobra.Command {
 				fromAddr,
 				sessionType,
 				sessionCode,
-				string(prettyJSON),
+				sessionArgs,
 				string(fee),
 			)
 
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			ttl := viper.GetDuration(FlagTTL)
+			dependencies := viper.GetStringSlice(FlagDependencies)
+			if ttl > 0 || len(dependencies) > 0 {
+				header := types.NewDeployHeader(time.Now(), ttl, dependencies)
+				msg.Header = &header
+			}
+
+			msg.FeePayer, err = GetFeePayer(cliCtx)
+			if err != nil {
+				return err
+			}
+			msg.AuthorizationKeys = authorizationKeys
+
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().Duration(FlagTTL, 0, "Time to live of the deploy (no expiry if not given)")
+	cmd.Flags().StringSlice(FlagDependencies, nil, "Hashes of the deploys which must be executed before the deploy")
+	cmd.Flags().AddFlagSet(fsFeePayer)
+	cmd.Flags().AddFlagSet(fsAuthorization)
+	cmd.Flags().AddFlagSet(fsSimulate)
+
+	return cmd
+}
+
+// GetCmdContractDeploy is the CLI command for deploying and registering a contract
+func GetCmdContractDeploy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy <wasm-path> <name> <argument> <fee> --from <from> [--metadata <metadata>]",
+		Short: "Deploy contract",
+		Long: "Deploy contract\n" +
+			"The wasm session code must store exactly one contract, which is registered under the name.",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			kb, err := client.NewKeyBaseFromDir(viper.GetString(client.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			valueFromFromFlag := viper.GetString(client.FlagFrom)
+			keyInfo, err := cliutil.GetLocalWalletInfo(valueFromFromFlag, kb, cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithFromAddress(keyInfo.GetAddress()).WithFromName(keyInfo.GetName())
+
+			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[3]))
+			if err != nil {
+				return err
+			}
+
+			sessionArgs, err := cliutil.NormalizeSessionArgs(args[2])
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgDeployContract(
+				keyInfo.GetAddress(),
+				args[1],
+				util.LoadWasmFile(args[0]),
+				sessionArgs,
+				viper.GetString(FlagMetadata),
+				string(fee),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().String(FlagMetadata, "", "Optional metadata of the contract, e.g. its description or ABI")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -110,10 +192,12 @@ func GetCmdContractRun(cdc *codec.Codec) *cobra.Command {
 // GetCmdTransfer is the CLI command for transfer
 func GetCmdTransfer(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "transfer-to <recipient_nickname>|<address> <amount> <fee> --from <from>",
+		Use:   "transfer-to <recipient_nickname>|<address> <amount> <fee> --from <from> [--fee-payer <fee-payer>] [--account <account>] [--authorization-keys <key>,...]",
 		Short: "Transfer Hdac token",
-		Long:  "Transfer Hdac token",
-		Args:  cobra.ExactArgs(3),
+		Long: "Transfer Hdac token\n" +
+			"A nickname resolves to its address record if set, or to its owner, " +
+			"and the transfer carries the memo record of the nickname unless --memo is given.",
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -122,10 +206,15 @@ func GetCmdTransfer(cdc *codec.Codec) *cobra.Command {
 			var recipentAddr sdk.AccAddress
 			recipentAddr, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
-				recipentAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, args[0])
+				recipentAddr, err = cliutil.GetRecipientAddress(cliCtx.Codec, cliCtx, args[0])
 				if err != nil {
 					return fmt.Errorf("no nickname mapping of %s", args[0])
 				}
+
+				// the payments to the nickname carry its memo, unless given
+				if memo, err := cliutil.GetRecord(cliCtx.Codec, cliCtx, args[0], idtype.RecordKeyMemo); err == nil && txBldr.Memo() == "" {
+					txBldr = txBldr.WithMemo(memo)
+				}
 			}
 
 			amount, err := cliutil.ToBigsun(cliutil.Hdac(args[1]))
@@ -148,18 +237,278 @@ func GetCmdTransfer(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			fromAddr := keyInfo.GetAddress()
-
 			cliCtx = cliCtx.WithFromAddress(keyInfo.GetAddress()).WithFromName(keyInfo.GetName())
+			fromAddr, authorizationKeys, err := GetAuthorization(cliCtx, keyInfo.GetAddress())
+			if err != nil {
+				return err
+			}
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgTransfer("transfer", fromAddr, recipentAddr, string(amount), string(fee))
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			msg.FeePayer, err = GetFeePayer(cliCtx)
+			if err != nil {
+				return err
+			}
+			msg.AuthorizationKeys = authorizationKeys
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsFeePayer)
+	cmd.Flags().AddFlagSet(fsAuthorization)
+	cmd.Flags().AddFlagSet(fsSimulate)
+
+	return cmd
+}
+
+// GetCmdMultiTransfer is the CLI command for transferring to many recipients in a single deploy
+func GetCmdMultiTransfer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-batch --file <recipients.csv> <fee> --from <from> [--fee-payer <fee-payer>] [--account <account>] [--authorization-keys <key>,...]",
+		Short: "Transfer Hdac token to many recipients at once",
+		Long: `Transfer Hdac token to many recipients at once.
+Each row of the recipients file is "<recipient_nickname>|<address>,<amount>".
+The transfers are all or nothing.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			recipients, err := ParseTransferRecipientsCSV(viper.GetString(FlagFile))
+			if err != nil {
+				return err
+			}
+
+			outputs := make([]types.TransferOutput, len(recipients))
+			for i, recipient := range recipients {
+				// Parse nickname of address
+				var recipentAddr sdk.AccAddress
+				recipentAddr, err := sdk.AccAddressFromBech32(recipient.RecipientAddressOrNickname)
+				if err != nil {
+					recipentAddr, err = cliutil.GetRecipientAddress(cliCtx.Codec, cliCtx, recipient.RecipientAddressOrNickname)
+					if err != nil {
+						return fmt.Errorf("no nickname mapping of %s", recipient.RecipientAddressOrNickname)
+					}
+				}
+
+				amount, err := cliutil.ToBigsun(cliutil.Hdac(recipient.Amount))
+				if err != nil {
+					return err
+				}
+				outputs[i] = types.NewTransferOutput(recipentAddr, string(amount))
+			}
+
+			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[0]))
+			if err != nil {
+				return err
+			}
+
+			kb, err := client.NewKeyBaseFromDir(viper.GetString(client.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			valueFromFromFlag := viper.GetString(client.FlagFrom)
+			keyInfo, err := cliutil.GetLocalWalletInfo(valueFromFromFlag, kb, cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithFromAddress(keyInfo.GetAddress()).WithFromName(keyInfo.GetName())
+			fromAddr, authorizationKeys, err := GetAuthorization(cliCtx, keyInfo.GetAddress())
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgMultiTransfer("transfer", fromAddr, outputs, string(fee))
+			msg.FeePayer, err = GetFeePayer(cliCtx)
+			if err != nil {
+				return err
+			}
+			msg.AuthorizationKeys = authorizationKeys
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagFile, "", "CSV file of the recipients and the amounts")
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsFeePayer)
+	cmd.Flags().AddFlagSet(fsAuthorization)
+	cmd.Flags().AddFlagSet(fsSimulate)
+	cmd.MarkFlagRequired(FlagFile)
+
+	return cmd
+}
+
+// GetCmdAddAssociatedKey is the CLI command for adding an associated key to an account
+func GetCmdAddAssociatedKey(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-associated-key <key_nickname>|<address> <weight> <fee> --from <from> [--account <account>] [--authorization-keys <key>,...]",
+		Short: "Add an associated key to the account",
+		Long: "Add an associated key with the weight to the account.\n" +
+			"The associated keys authorize the deploys of the account together, if their total weight reaches the action thresholds.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			associatedKey, err := parseAddressOrNickname(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			weight, err := strconv.ParseUint(args[1], 10, 8)
+			if err != nil {
+				return fmt.Errorf("weight must be between 1 and 255: %s", err.Error())
+			}
+
+			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[2]))
+			if err != nil {
+				return err
+			}
+
+			kb, err := client.NewKeyBaseFromDir(viper.GetString(client.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			valueFromFromFlag := viper.GetString(client.FlagFrom)
+			keyInfo, err := cliutil.GetLocalWalletInfo(valueFromFromFlag, kb, cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithFromAddress(keyInfo.GetAddress()).WithFromName(keyInfo.GetName())
+			account, authorizationKeys, err := GetAuthorization(cliCtx, keyInfo.GetAddress())
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgAddAssociatedKey("system:add_associated_key", account, associatedKey, uint8(weight), string(fee), authorizationKeys)
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsAuthorization)
+	cmd.Flags().AddFlagSet(fsSimulate)
+
+	return cmd
+}
+
+// GetCmdRemoveAssociatedKey is the CLI command for removing an associated key from an account
+func GetCmdRemoveAssociatedKey(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-associated-key <key_nickname>|<address> <fee> --from <from> [--account <account>] [--authorization-keys <key>,...]",
+		Short: "Remove an associated key from the account",
+		Long: "Remove an associated key from the account.\n" +
+			"The total weight of the remaining keys can't go below the key management threshold.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			associatedKey, err := parseAddressOrNickname(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[1]))
+			if err != nil {
+				return err
+			}
+
+			kb, err := client.NewKeyBaseFromDir(viper.GetString(client.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			valueFromFromFlag := viper.GetString(client.FlagFrom)
+			keyInfo, err := cliutil.GetLocalWalletInfo(valueFromFromFlag, kb, cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithFromAddress(keyInfo.GetAddress()).WithFromName(keyInfo.GetName())
+			account, authorizationKeys, err := GetAuthorization(cliCtx, keyInfo.GetAddress())
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgRemoveAssociatedKey("system:remove_associated_key", account, associatedKey, string(fee), authorizationKeys)
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsAuthorization)
+	cmd.Flags().AddFlagSet(fsSimulate)
+
+	return cmd
+}
+
+// GetCmdSetActionThresholds is the CLI command for setting the action thresholds of an account
+func GetCmdSetActionThresholds(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-action-thresholds <deployment> <key-management> <fee> --from <from> [--account <account>] [--authorization-keys <key>,...]",
+		Short: "Set the action thresholds of the account",
+		Long: "Set the total weights of the associated keys required to deploy, and to manage the keys of the account.\n" +
+			"The deployment threshold can't exceed the key management threshold.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			deploymentThreshold, err := strconv.ParseUint(args[0], 10, 8)
+			if err != nil {
+				return fmt.Errorf("deployment threshold must be between 1 and 255: %s", err.Error())
+			}
+
+			keyManagementThreshold, err := strconv.ParseUint(args[1], 10, 8)
+			if err != nil {
+				return fmt.Errorf("key management threshold must be between 1 and 255: %s", err.Error())
+			}
+
+			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[2]))
+			if err != nil {
+				return err
+			}
+
+			kb, err := client.NewKeyBaseFromDir(viper.GetString(client.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			valueFromFromFlag := viper.GetString(client.FlagFrom)
+			keyInfo, err := cliutil.GetLocalWalletInfo(valueFromFromFlag, kb, cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithFromAddress(keyInfo.GetAddress()).WithFromName(keyInfo.GetName())
+			account, authorizationKeys, err := GetAuthorization(cliCtx, keyInfo.GetAddress())
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgSetActionThresholds("system:set_action_thresholds", account,
+				uint8(deploymentThreshold), uint8(keyManagementThreshold), string(fee), authorizationKeys)
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsAuthorization)
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -201,12 +550,13 @@ func GetCmdBonding(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgBond("system:bond", addr, string(amount), string(fee))
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -248,12 +598,13 @@ func GetCmdUnbonding(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgUnBond("system:unbond", addr, string(amount), string(fee))
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -302,12 +653,13 @@ func GetCmdDelegate(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgDelegate("system:delegate", addr, valAddress, string(amount), string(fee))
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -356,12 +708,13 @@ func GetCmdUndelegate(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgUndelegate("system:undelegate", addr, valAddress, string(amount), string(fee))
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -418,12 +771,13 @@ func GetCmdRedelegate(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgRedelegate("system:redelegate", addr, srcValAddress, destValAddress, string(amount), string(fee))
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -477,12 +831,13 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgVote("system:vote", addr, contractAddress, string(amount), string(fee))
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -532,12 +887,13 @@ func GetCmdUnvote(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgUnvote("system:unvote", addr, contractAddress, string(amount), string(fee))
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -581,12 +937,13 @@ func GetCmdClaimReward(cdc *codec.Codec) *cobra.Command {
 
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgClaim(fmt.Sprintf("system:claim_%s", args[0]), addr, isRewardOrCommission, string(fee))
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	return cmd
 }
@@ -595,7 +952,7 @@ func GetCmdClaimReward(cdc *codec.Codec) *cobra.Command {
 func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "create-validator <fee> --from <from> --pubkey <validator_cons_pubkey> " +
-			"[--moniker <moniker>] [--identity <identity>] [--website <site_address>] [--details <detail_description>]",
+			"[--moniker <moniker>] [--identity <identity>] [--website <site_address>] [--details <detail_description>] [--commission-rate <rate>]",
 		Short: "create new validator initialized with a self-delegation to it",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -629,24 +986,27 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 				viper.GetString(FlagDetails),
 			)
 
-			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[0]))
+			commissionRate, err := sdk.NewDecFromStr(viper.GetString(FlagCommissionRate))
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgCreateValidator("system:create_validator", valAddr, consPubKey, description, string(fee))
-
+			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[0]))
 			if err != nil {
 				return err
 			}
 
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			msg := types.NewMsgCreateValidator("system:create_validator", valAddr, consPubKey, description, commissionRate, string(fee))
+
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 	cmd.Flags().AddFlagSet(fsDescriptionCreate)
+	cmd.Flags().AddFlagSet(fsCommissionCreate)
 	cmd.Flags().AddFlagSet(FsPk)
 
 	cmd.MarkFlagRequired(FlagPubKey)
@@ -659,7 +1019,7 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "edit-validator <fee> --from <from> " +
-			"[--moniker <moniker>] [--identity <identity>] [--website <site_address>] [--details <detail_description>]",
+			"[--moniker <moniker>] [--identity <identity>] [--website <site_address>] [--details <detail_description>] [--commission-rate <rate>]",
 		Short: "edit an existing validator account",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -686,22 +1046,119 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 				Details:  viper.GetString(FlagDetails),
 			}
 
+			var commissionRate *sdk.Dec
+			if rateStr := viper.GetString(FlagCommissionRate); rateStr != "" {
+				rate, err := sdk.NewDecFromStr(rateStr)
+				if err != nil {
+					return err
+				}
+				commissionRate = &rate
+			}
+
 			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[0]))
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgEditValidator("system:edit_validator", valAddr, description, string(fee))
+			msg := types.NewMsgEditValidator("system:edit_validator", valAddr, description, commissionRate, string(fee))
 
 			// build and sign the transaction, then broadcast to Tendermint
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
 	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	cmd.Flags().AddFlagSet(fsDescriptionEdit)
+	cmd.Flags().AddFlagSet(fsCommissionEdit)
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+// GetCmdJail implements the jail validator command.
+func GetCmdJail(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jail <fee> --from <from>",
+		Short: "jail a validator by itself, taking it out of the validator set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			kb, err := client.NewKeyBaseFromDir(viper.GetString(client.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			valueFromFromFlag := viper.GetString(client.FlagFrom)
+			keyInfo, err := cliutil.GetLocalWalletInfo(valueFromFromFlag, kb, cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithFromAddress(keyInfo.GetAddress()).WithFromName(keyInfo.GetName())
+
+			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[0]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgJail("system:jail", cliCtx.GetFromAddress(), string(fee))
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+// GetCmdUnjail implements the unjail validator command.
+func GetCmdUnjail(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail <fee> --from <from>",
+		Short: "unjail a validator previously jailed for downtime or by itself",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			kb, err := client.NewKeyBaseFromDir(viper.GetString(client.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			valueFromFromFlag := viper.GetString(client.FlagFrom)
+			keyInfo, err := cliutil.GetLocalWalletInfo(valueFromFromFlag, kb, cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithFromAddress(keyInfo.GetAddress()).WithFromName(keyInfo.GetName())
+
+			fee, err := cliutil.ToBigsun(cliutil.Hdac(args[0]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnjail("system:unjail", cliCtx.GetFromAddress(), string(fee))
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return generateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(client.FlagFrom, "", "Executor's identity (one of wallet alias, address, nickname)")
+	cmd.Flags().AddFlagSet(fsSimulate)
 
 	cmd.MarkFlagRequired(client.FlagFrom)
 
@@ -725,7 +1182,121 @@ func BuildCreateValidatorMsg(cliCtx context.CLIContext) (sdk.Msg, error) {
 		viper.GetString(FlagDetails),
 	)
 
-	msg := types.NewMsgCreateValidator("system:create_validator", valAddr, consPubKey, description, types.BASIC_FEE)
+	commissionRate := sdk.ZeroDec()
+	if rateStr := viper.GetString(FlagCommissionRate); rateStr != "" {
+		commissionRate, err = sdk.NewDecFromStr(rateStr)
+		if err != nil {
+			return types.MsgCreateValidator{}, err
+		}
+	}
+
+	msg := types.NewMsgCreateValidator("system:create_validator", valAddr, consPubKey, description, commissionRate, types.BASIC_FEE)
 
 	return msg, nil
 }
+
+// generateOrBroadcastMsgs simulates the messages on the execution engine with --dry-run,
+// otherwise it generates or broadcasts them
+// GetCmdSubmitEngineUpgradeProposal implements the command to submit an engine upgrade proposal
+func GetCmdSubmitEngineUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "engine-upgrade [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an execution engine upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an execution engine upgrade proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+The wasm costs and the installer are optional.
+
+Example:
+$ %s tx gov submit-proposal engine-upgrade <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Engine Upgrade",
+  "description": "Upgrade the system contracts",
+  "height": "100000",
+  "protocol_version": "1.1.0",
+  "wasm_costs": {
+    "regular": 1,
+    "div_multiplier": 16,
+    "mul_multiplier": 4,
+    "mem_multiplier": 2,
+    "mem_initial_pages": 4096,
+    "mem_grow_per_page": 8192,
+    "mem_copy_per_byte": 1,
+    "max_stack_height": 65536,
+    "opcodes_multiplier": 3,
+    "opcodes_divisor": 8
+  },
+  "installer": "path/to/upgrade_install.wasm",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseEngineUpgradeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			var installer []byte
+			if proposal.Installer != "" {
+				installer, err = ioutil.ReadFile(proposal.Installer)
+				if err != nil {
+					return err
+				}
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewEngineUpgradeProposal(
+				proposal.Title, proposal.Description, proposal.Height, proposal.ProtocolVersion, proposal.WasmCosts, installer)
+
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func generateOrBroadcastMsgs(cliCtx context.CLIContext, txBldr auth.TxBuilder, msgs []sdk.Msg) error {
+	// a tx signed by several keys, e.g. with a fee payer, is only generated to be signed by each of them
+	if !cliCtx.GenerateOnly && !cliCtx.Simulate {
+		for _, msg := range msgs {
+			if signers := msg.GetSigners(); len(signers) > 1 {
+				return fmt.Errorf("the tx must be signed by %d keys. Use --generate-only, and sign it with each key", len(signers))
+			}
+		}
+	}
+
+	if !cliCtx.Simulate {
+		return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, msgs)
+	}
+
+	results, err := cliutil.SimulateMsgs(cliCtx, msgs)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if err := cliCtx.PrintOutput(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
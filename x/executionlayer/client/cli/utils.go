@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/hdac-io/friday/client/context"
+	"github.com/hdac-io/friday/codec"
+	sdk "github.com/hdac-io/friday/types"
+	cliutil "github.com/hdac-io/friday/x/executionlayer/client/util"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+)
+
+type (
+	// EngineUpgradeProposalJSON defines an EngineUpgradeProposal with a deposit.
+	// The installer is the path of the installer wasm file.
+	EngineUpgradeProposalJSON struct {
+		Title           string           `json:"title" yaml:"title"`
+		Description     string           `json:"description" yaml:"description"`
+		Height          int64            `json:"height" yaml:"height"`
+		ProtocolVersion string           `json:"protocol_version" yaml:"protocol_version"`
+		WasmCosts       *types.WasmCosts `json:"wasm_costs" yaml:"wasm_costs"`
+		Installer       string           `json:"installer" yaml:"installer"`
+		Deposit         sdk.Coins        `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseEngineUpgradeProposalJSON reads and parses an EngineUpgradeProposalJSON from a file.
+func ParseEngineUpgradeProposalJSON(cdc *codec.Codec, proposalFile string) (EngineUpgradeProposalJSON, error) {
+	proposal := EngineUpgradeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// TransferRecipient is a row of a recipients file, a recipient nickname or address and an amount in Hdac.
+type TransferRecipient struct {
+	RecipientAddressOrNickname string
+	Amount                     string
+}
+
+// ParseTransferRecipientsCSV reads and parses the recipients of a batch transfer from a csv file.
+// Each row is "<recipient_nickname>|<address>,<amount>".
+func ParseTransferRecipientsCSV(recipientsFile string) ([]TransferRecipient, error) {
+	file, err := os.Open(recipientsFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return readTransferRecipients(file)
+}
+
+func readTransferRecipients(r io.Reader) ([]TransferRecipient, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no recipients in the file")
+	}
+
+	recipients := make([]TransferRecipient, len(records))
+	for i, record := range records {
+		recipients[i] = TransferRecipient{
+			RecipientAddressOrNickname: strings.TrimSpace(record[0]),
+			Amount:                     strings.TrimSpace(record[1]),
+		}
+	}
+	return recipients, nil
+}
+
+// GetFeePayer returns the fee payer given by the flag, or nil if not given.
+func GetFeePayer(cliCtx context.CLIContext) (sdk.AccAddress, error) {
+	value := viper.GetString(FlagFeePayer)
+	if value == "" {
+		return nil, nil
+	}
+	return parseAddressOrNickname(cliCtx, value)
+}
+
+// GetAuthorization returns the account given by the flag, and the keys authorizing the deploy of the account.
+// The account is the sender if not given. The sender authorizes the deploy of another account
+// as its associated key, unless the authorization keys are given.
+func GetAuthorization(cliCtx context.CLIContext, fromAddr sdk.AccAddress) (sdk.AccAddress, []sdk.AccAddress, error) {
+	account := fromAddr
+	if value := viper.GetString(FlagAccount); value != "" {
+		var err error
+		account, err = parseAddressOrNickname(cliCtx, value)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	authorizationKeys := []sdk.AccAddress{}
+	for _, value := range viper.GetStringSlice(FlagAuthorizationKeys) {
+		key, err := parseAddressOrNickname(cliCtx, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		authorizationKeys = append(authorizationKeys, key)
+	}
+
+	if len(authorizationKeys) == 0 {
+		if account.Equals(fromAddr) {
+			return account, nil, nil
+		}
+		authorizationKeys = append(authorizationKeys, fromAddr)
+	}
+	return account, authorizationKeys, nil
+}
+
+func parseAddressOrNickname(cliCtx context.CLIContext, value string) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(value)
+	if err != nil {
+		addr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, value)
+		if err != nil {
+			return nil, fmt.Errorf("no nickname mapping of %s", value)
+		}
+	}
+	return addr, nil
+}
@@ -0,0 +1,12 @@
+package client
+
+import (
+	"github.com/hdac-io/friday/x/executionlayer/client/cli"
+	"github.com/hdac-io/friday/x/executionlayer/client/rest"
+	govclient "github.com/hdac-io/friday/x/gov/client"
+)
+
+// engine upgrade proposal handler
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitEngineUpgradeProposal, rest.ProposalRESTHandler)
+)
@@ -6,12 +6,14 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hdac-io/friday/client/context"
 	sdk "github.com/hdac-io/friday/types"
 	"github.com/hdac-io/friday/types/rest"
 	cliutil "github.com/hdac-io/friday/x/executionlayer/client/util"
 	"github.com/hdac-io/friday/x/executionlayer/types"
+	idtype "github.com/hdac-io/friday/x/nickname/types"
 
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
 )
@@ -23,6 +25,32 @@ type contractRunReq struct {
 	Base64EncodedBinary           string       `json:"base64_encoded_binary"`
 	Args                          string       `json:"args"`
 	Fee                           string       `json:"fee"`
+	TTLMillis                     uint32       `json:"ttl_millis"`
+	Dependencies                  []string     `json:"dependencies"`
+	FeePayer                      string       `json:"fee_payer"`
+	Account                       string       `json:"account"`
+	AuthorizationKeys             []string     `json:"authorization_keys"`
+}
+
+type contractDeployReq struct {
+	BaseReq             rest.BaseReq `json:"base_req"`
+	Name                string       `json:"name"`
+	Base64EncodedBinary string       `json:"base64_encoded_binary"`
+	Args                string       `json:"args"`
+	Metadata            string       `json:"metadata"`
+	Fee                 string       `json:"fee"`
+}
+
+type engineUpgradeProposalReq struct {
+	BaseReq                rest.BaseReq     `json:"base_req"`
+	Title                  string           `json:"title"`
+	Description            string           `json:"description"`
+	Height                 int64            `json:"height"`
+	ProtocolVersion        string           `json:"protocol_version"`
+	WasmCosts              *types.WasmCosts `json:"wasm_costs"`
+	Base64EncodedInstaller string           `json:"base64_encoded_installer"`
+	Proposer               sdk.AccAddress   `json:"proposer"`
+	Deposit                sdk.Coins        `json:"deposit"`
 }
 
 func contractRunMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
@@ -47,6 +75,11 @@ func contractRunMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *
 		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse base request")
 	}
 
+	accountAddr, authorizationKeys, err := parseAuthorization(cliCtx, senderAddr, req.Account, req.AuthorizationKeys)
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
 	sessionType := cliutil.GetContractType(req.ExecutionType)
 	var sessionCode []byte
 	var contractAddress string
@@ -59,21 +92,21 @@ func contractRunMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *
 			return rest.BaseReq{}, nil, fmt.Errorf("failed to decode WASM binary")
 		}
 	case util.HASH:
-		contractAddress = req.TokenContractAddressOrKeyName
-		contractHashAddr, err := sdk.ContractHashAddressFromBech32(req.TokenContractAddressOrKeyName)
+		contractHashAddr, err := cliutil.GetContractHashAddress(cliCtx.Codec, cliCtx, req.TokenContractAddressOrKeyName)
 		if err != nil {
 			return rest.BaseReq{}, nil, fmt.Errorf("failed to decode given contract hash address")
 		}
+		contractAddress = contractHashAddr.String()
 		sessionCode = contractHashAddr.Bytes()
 	case util.UREF:
-		contractAddress = req.TokenContractAddressOrKeyName
-		contractUrefAddr, err := sdk.ContractUrefAddressFromBech32(req.TokenContractAddressOrKeyName)
+		contractUrefAddr, err := cliutil.GetContractUrefAddress(cliCtx.Codec, cliCtx, req.TokenContractAddressOrKeyName)
 		if err != nil {
 			return rest.BaseReq{}, nil, fmt.Errorf("failed to decode given contract uref address")
 		}
+		contractAddress = contractUrefAddr.String()
 		sessionCode = contractUrefAddr.Bytes()
 	case util.NAME:
-		contractAddress = fmt.Sprintf("%s:%s", senderAddr.String(), req.TokenContractAddressOrKeyName)
+		contractAddress = fmt.Sprintf("%s:%s", accountAddr.String(), req.TokenContractAddressOrKeyName)
 		sessionCode = []byte(req.TokenContractAddressOrKeyName)
 	default:
 		return rest.BaseReq{}, nil, fmt.Errorf("type must be one of wasm, name, uref, or hash")
@@ -84,13 +117,82 @@ func contractRunMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *
 		return rest.BaseReq{}, nil, fmt.Errorf("error on conversion from bigsun to token")
 	}
 
+	sessionArgs, err := cliutil.NormalizeSessionArgs(req.Args)
+	if err != nil {
+		return rest.BaseReq{}, nil, fmt.Errorf("invalid args: %s", err.Error())
+	}
+
 	// build and sign the transaction, then broadcast to Tendermint
 	msg := types.NewMsgExecute(
 		contractAddress,
-		senderAddr,
+		accountAddr,
 		sessionType,
 		sessionCode,
-		req.Args,
+		sessionArgs,
+		string(fee),
+	)
+	if req.TTLMillis > 0 || len(req.Dependencies) > 0 {
+		header := types.NewDeployHeader(time.Now(), time.Duration(req.TTLMillis)*time.Millisecond, req.Dependencies)
+		msg.Header = &header
+	}
+	msg.FeePayer, err = parseFeePayer(cliCtx, req.FeePayer)
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+	msg.AuthorizationKeys = authorizationKeys
+
+	err = msg.ValidateBasic()
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	return req.BaseReq, []sdk.Msg{msg}, nil
+}
+
+func contractDeployMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
+	var req contractDeployReq
+
+	// Get body parameters
+	if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse request")
+	}
+
+	var senderAddr sdk.AccAddress
+	senderAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		senderAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, req.BaseReq.From)
+		if err != nil {
+			return rest.BaseReq{}, nil, fmt.Errorf("failed to parse sender address or name: %s", req.BaseReq.From)
+		}
+	}
+
+	req.BaseReq.From = senderAddr.String()
+	if !req.BaseReq.ValidateBasic(w) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse base request")
+	}
+
+	sessionCode, err := base64.StdEncoding.DecodeString(req.Base64EncodedBinary)
+	if err != nil {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to decode WASM binary")
+	}
+
+	fee, err := cliutil.ToBigsun(cliutil.Hdac(req.Fee))
+	if err != nil {
+		return rest.BaseReq{}, nil, fmt.Errorf("error on conversion from bigsun to token")
+	}
+
+	sessionArgs, err := cliutil.NormalizeSessionArgs(req.Args)
+	if err != nil {
+		return rest.BaseReq{}, nil, fmt.Errorf("invalid args: %s", err.Error())
+	}
+
+	// build and sign the transaction, then broadcast to Tendermint
+	msg := types.NewMsgDeployContract(
+		senderAddr,
+		req.Name,
+		sessionCode,
+		sessionArgs,
+		req.Metadata,
 		string(fee),
 	)
 
@@ -118,11 +220,54 @@ func getContractQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *ht
 	return bz, path, nil
 }
 
+func getContractRegistryQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) ([]byte, string, error) {
+	vars := r.URL.Query()
+	if name := vars.Get("name"); name != "" {
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryContractParams(name))
+		return bz, "querycontract", nil
+	}
+
+	var deployerAddress sdk.AccAddress
+	if deployerAddressStr := vars.Get("deployer"); deployerAddressStr != "" {
+		var err error
+		deployerAddress, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, deployerAddressStr)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryContractsParams(deployerAddress))
+	return bz, "querycontracts", nil
+}
+
+func getContractStateQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) ([]byte, error) {
+	vars := r.URL.Query()
+	depth := 0
+	if depthStr := vars.Get("depth"); depthStr != "" {
+		var err error
+		depth, err = strconv.Atoi(depthStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid depth: %s", depthStr)
+		}
+	}
+
+	queryData := types.QueryStateParams{
+		KeyType: vars.Get("data_type"),
+		KeyData: vars.Get("data"),
+		Path:    vars.Get("path"),
+		Depth:   depth,
+	}
+	return cliCtx.Codec.MustMarshalJSON(queryData), nil
+}
+
 type transferReq struct {
 	BaseReq                    rest.BaseReq `json:"base_req"`
 	RecipientAddressOrNickname string       `json:"recipient_address_or_nickname"`
 	Amount                     string       `json:"amount"`
 	Fee                        string       `json:"fee"`
+	FeePayer                   string       `json:"fee_payer"`
+	Account                    string       `json:"account"`
+	AuthorizationKeys          []string     `json:"authorization_keys"`
 }
 
 func transferMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
@@ -147,14 +292,24 @@ func transferMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *htt
 		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse base request")
 	}
 
+	accountAddr, authorizationKeys, err := parseAuthorization(cliCtx, senderAddr, req.Account, req.AuthorizationKeys)
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
 	// Parameter touching
 	var recipientAddr sdk.AccAddress
 	recipientAddr, err = sdk.AccAddressFromBech32(req.RecipientAddressOrNickname)
 	if err != nil {
-		recipientAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, req.RecipientAddressOrNickname)
+		recipientAddr, err = cliutil.GetRecipientAddress(cliCtx.Codec, cliCtx, req.RecipientAddressOrNickname)
 		if err != nil {
 			return rest.BaseReq{}, nil, fmt.Errorf("failed to parse recipient address or name: %s", req.RecipientAddressOrNickname)
 		}
+
+		// the payments to the nickname carry its memo, unless given
+		if memo, err := cliutil.GetRecord(cliCtx.Codec, cliCtx, req.RecipientAddressOrNickname, idtype.RecordKeyMemo); err == nil && req.BaseReq.Memo == "" {
+			req.BaseReq.Memo = memo
+		}
 	}
 
 	amount, err := cliutil.ToBigsun(cliutil.Hdac(req.Amount))
@@ -168,7 +323,210 @@ func transferMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *htt
 	}
 
 	// create the message
-	eeMsg := types.NewMsgTransfer("system:transfer", senderAddr, recipientAddr, string(amount), string(fee))
+	eeMsg := types.NewMsgTransfer("system:transfer", accountAddr, recipientAddr, string(amount), string(fee))
+	eeMsg.FeePayer, err = parseFeePayer(cliCtx, req.FeePayer)
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+	eeMsg.AuthorizationKeys = authorizationKeys
+	err = eeMsg.ValidateBasic()
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	return req.BaseReq, []sdk.Msg{eeMsg}, nil
+}
+
+type transferOutputReq struct {
+	RecipientAddressOrNickname string `json:"recipient_address_or_nickname"`
+	Amount                     string `json:"amount"`
+}
+
+type multiTransferReq struct {
+	BaseReq           rest.BaseReq        `json:"base_req"`
+	Outputs           []transferOutputReq `json:"outputs"`
+	Fee               string              `json:"fee"`
+	FeePayer          string              `json:"fee_payer"`
+	Account           string              `json:"account"`
+	AuthorizationKeys []string            `json:"authorization_keys"`
+}
+
+func multiTransferMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
+	var req multiTransferReq
+
+	// Get body parameters
+	if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse request")
+	}
+
+	var senderAddr sdk.AccAddress
+	senderAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		senderAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, req.BaseReq.From)
+		if err != nil {
+			return rest.BaseReq{}, nil, fmt.Errorf("failed to parse sender address or name: %s", req.BaseReq.From)
+		}
+	}
+
+	req.BaseReq.From = senderAddr.String()
+	if !req.BaseReq.ValidateBasic(w) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse base request")
+	}
+
+	accountAddr, authorizationKeys, err := parseAuthorization(cliCtx, senderAddr, req.Account, req.AuthorizationKeys)
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	// Parameter touching
+	outputs := make([]types.TransferOutput, len(req.Outputs))
+	for i, output := range req.Outputs {
+		var recipientAddr sdk.AccAddress
+		recipientAddr, err = sdk.AccAddressFromBech32(output.RecipientAddressOrNickname)
+		if err != nil {
+			recipientAddr, err = cliutil.GetRecipientAddress(cliCtx.Codec, cliCtx, output.RecipientAddressOrNickname)
+			if err != nil {
+				return rest.BaseReq{}, nil, fmt.Errorf("failed to parse recipient address or name: %s", output.RecipientAddressOrNickname)
+			}
+		}
+
+		amount, err := cliutil.ToBigsun(cliutil.Hdac(output.Amount))
+		if err != nil {
+			return rest.BaseReq{}, nil, err
+		}
+		outputs[i] = types.NewTransferOutput(recipientAddr, string(amount))
+	}
+
+	fee, err := cliutil.ToBigsun(cliutil.Hdac(req.Fee))
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	// create the message
+	eeMsg := types.NewMsgMultiTransfer("system:transfer", accountAddr, outputs, string(fee))
+	eeMsg.FeePayer, err = parseFeePayer(cliCtx, req.FeePayer)
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+	eeMsg.AuthorizationKeys = authorizationKeys
+	err = eeMsg.ValidateBasic()
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	return req.BaseReq, []sdk.Msg{eeMsg}, nil
+}
+
+type associatedKeyReq struct {
+	BaseReq                        rest.BaseReq `json:"base_req"`
+	AssociatedKeyAddressOrNickname string       `json:"associated_key_address_or_nickname"`
+	Weight                         uint8        `json:"weight"`
+	Fee                            string       `json:"fee"`
+	Account                        string       `json:"account"`
+	AuthorizationKeys              []string     `json:"authorization_keys"`
+}
+
+func associatedKeyMsgCreator(addIsTrue bool, w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
+	var req associatedKeyReq
+
+	// Get body parameters
+	if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse request")
+	}
+
+	var senderAddr sdk.AccAddress
+	senderAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		senderAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, req.BaseReq.From)
+		if err != nil {
+			return rest.BaseReq{}, nil, fmt.Errorf("failed to parse sender address or name: %s", req.BaseReq.From)
+		}
+	}
+
+	req.BaseReq.From = senderAddr.String()
+	if !req.BaseReq.ValidateBasic(w) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse base request")
+	}
+
+	accountAddr, authorizationKeys, err := parseAuthorization(cliCtx, senderAddr, req.Account, req.AuthorizationKeys)
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	// Parameter touching
+	var associatedKey sdk.AccAddress
+	associatedKey, err = sdk.AccAddressFromBech32(req.AssociatedKeyAddressOrNickname)
+	if err != nil {
+		associatedKey, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, req.AssociatedKeyAddressOrNickname)
+		if err != nil {
+			return rest.BaseReq{}, nil, fmt.Errorf("failed to parse associated key address or name: %s", req.AssociatedKeyAddressOrNickname)
+		}
+	}
+
+	fee, err := cliutil.ToBigsun(cliutil.Hdac(req.Fee))
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	// create the message
+	var eeMsg sdk.Msg
+	if addIsTrue {
+		eeMsg = types.NewMsgAddAssociatedKey("system:add_associated_key", accountAddr, associatedKey, req.Weight, string(fee), authorizationKeys)
+	} else {
+		eeMsg = types.NewMsgRemoveAssociatedKey("system:remove_associated_key", accountAddr, associatedKey, string(fee), authorizationKeys)
+	}
+	err = eeMsg.ValidateBasic()
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	return req.BaseReq, []sdk.Msg{eeMsg}, nil
+}
+
+type actionThresholdsReq struct {
+	BaseReq                rest.BaseReq `json:"base_req"`
+	DeploymentThreshold    uint8        `json:"deployment_threshold"`
+	KeyManagementThreshold uint8        `json:"key_management_threshold"`
+	Fee                    string       `json:"fee"`
+	Account                string       `json:"account"`
+	AuthorizationKeys      []string     `json:"authorization_keys"`
+}
+
+func actionThresholdsMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
+	var req actionThresholdsReq
+
+	// Get body parameters
+	if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse request")
+	}
+
+	var senderAddr sdk.AccAddress
+	senderAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		senderAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, req.BaseReq.From)
+		if err != nil {
+			return rest.BaseReq{}, nil, fmt.Errorf("failed to parse sender address or name: %s", req.BaseReq.From)
+		}
+	}
+
+	req.BaseReq.From = senderAddr.String()
+	if !req.BaseReq.ValidateBasic(w) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse base request")
+	}
+
+	accountAddr, authorizationKeys, err := parseAuthorization(cliCtx, senderAddr, req.Account, req.AuthorizationKeys)
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	fee, err := cliutil.ToBigsun(cliutil.Hdac(req.Fee))
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	// create the message
+	eeMsg := types.NewMsgSetActionThresholds("system:set_action_thresholds", accountAddr,
+		req.DeploymentThreshold, req.KeyManagementThreshold, string(fee), authorizationKeys)
 	err = eeMsg.ValidateBasic()
 	if err != nil {
 		return rest.BaseReq{}, nil, err
@@ -529,15 +887,6 @@ func getCommissionQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *
 func getBalanceQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request, storeName string) ([]byte, error, context.CLIContext) {
 	vars := r.URL.Query()
 	straddr := vars.Get("address")
-	heightStr := vars.Get("height")
-	if len(heightStr) == 0 {
-		heightStr = "0"
-	}
-	height, err := strconv.ParseInt(heightStr, 10, 64)
-	if err != nil {
-		return nil, err, cliCtx
-	}
-
 	addr, err := cliutil.GetAddress(cliCtx.Codec, cliCtx, straddr)
 	if err != nil {
 		return nil, err, cliCtx
@@ -549,7 +898,6 @@ func getBalanceQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *htt
 	queryData := types.QueryGetBalanceDetail{
 		Address: addr,
 	}
-	cliCtx = cliCtx.WithHeight(height)
 	bz := cliCtx.Codec.MustMarshalJSON(queryData)
 
 	return bz, nil, cliCtx
@@ -558,15 +906,6 @@ func getBalanceQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *htt
 func getStakeQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request, storeName string) ([]byte, error, context.CLIContext) {
 	vars := r.URL.Query()
 	straddr := vars.Get("address")
-	heightStr := vars.Get("height")
-	if len(heightStr) == 0 {
-		heightStr = "0"
-	}
-	height, err := strconv.ParseInt(heightStr, 10, 64)
-	if err != nil {
-		return nil, err, cliCtx
-	}
-
 	addr, err := cliutil.GetAddress(cliCtx.Codec, cliCtx, straddr)
 	if err != nil {
 		return nil, err, cliCtx
@@ -575,7 +914,6 @@ func getStakeQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *http.
 	queryData := types.QueryGetStakeDetail{
 		Address: addr,
 	}
-	cliCtx = cliCtx.WithHeight(height)
 	bz := cliCtx.Codec.MustMarshalJSON(queryData)
 
 	return bz, nil, cliCtx
@@ -585,15 +923,6 @@ func getVoteQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *http.R
 	vars := r.URL.Query()
 	straddr := vars.Get("address")
 	strdapp := vars.Get("dapp")
-	heightStr := vars.Get("height")
-	if len(heightStr) == 0 {
-		heightStr = "0"
-	}
-
-	height, err := strconv.ParseInt(heightStr, 10, 64)
-	if err != nil {
-		return nil, err, cliCtx
-	}
 
 	var bz []byte
 	queryData := types.QueryGetVoteDetail{}
@@ -608,12 +937,10 @@ func getVoteQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *http.R
 		}
 		queryData.Address = addr
 
-		cliCtx = cliCtx.WithHeight(height)
 		bz = cliCtx.Codec.MustMarshalJSON(queryData)
 	} else if strdapp != "" {
 		queryData.Dapp = strdapp
 
-		cliCtx = cliCtx.WithHeight(height)
 		bz = cliCtx.Codec.MustMarshalJSON(queryData)
 	}
 
@@ -621,10 +948,11 @@ func getVoteQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *http.R
 }
 
 type createValidatorReq struct {
-	BaseReq     rest.BaseReq      `json:"base_req"`
-	ConsPubKey  string            `json:"cons_pub_key"`
-	Description types.Description `json:"description"`
-	Fee         string            `json:"fee"`
+	BaseReq        rest.BaseReq      `json:"base_req"`
+	ConsPubKey     string            `json:"cons_pub_key"`
+	Description    types.Description `json:"description"`
+	CommissionRate string            `json:"commission_rate"` // 0 if empty
+	Fee            string            `json:"fee"`
 }
 
 func createValidatorMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
@@ -655,13 +983,21 @@ func createValidatorMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext,
 		return rest.BaseReq{}, nil, err
 	}
 
+	commissionRate := sdk.ZeroDec()
+	if req.CommissionRate != "" {
+		commissionRate, err = sdk.NewDecFromStr(req.CommissionRate)
+		if err != nil {
+			return rest.BaseReq{}, nil, err
+		}
+	}
+
 	fee, err := cliutil.ToBigsun(cliutil.Hdac(req.Fee))
 	if err != nil {
 		return rest.BaseReq{}, nil, err
 	}
 
 	// create the message
-	msg := types.NewMsgCreateValidator("system:create_validator", valAddr, consPubKey, req.Description, string(fee))
+	msg := types.NewMsgCreateValidator("system:create_validator", valAddr, consPubKey, req.Description, commissionRate, string(fee))
 	err = msg.ValidateBasic()
 	if err != nil {
 		return rest.BaseReq{}, nil, err
@@ -671,9 +1007,10 @@ func createValidatorMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext,
 }
 
 type editValidatorReq struct {
-	BaseReq     rest.BaseReq      `json:"base_req"`
-	Description types.Description `json:"description"`
-	Fee         string            `json:"fee"`
+	BaseReq        rest.BaseReq      `json:"base_req"`
+	Description    types.Description `json:"description"`
+	CommissionRate string            `json:"commission_rate"` // not changed if empty
+	Fee            string            `json:"fee"`
 }
 
 func editValidatorMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
@@ -698,13 +1035,106 @@ func editValidatorMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r
 		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse base request")
 	}
 
+	var commissionRate *sdk.Dec
+	if req.CommissionRate != "" {
+		rate, err := sdk.NewDecFromStr(req.CommissionRate)
+		if err != nil {
+			return rest.BaseReq{}, nil, err
+		}
+		commissionRate = &rate
+	}
+
+	fee, err := cliutil.ToBigsun(cliutil.Hdac(req.Fee))
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	// create the message
+	msg := types.NewMsgEditValidator("system:edit_validator", valAddr, req.Description, commissionRate, string(fee))
+	err = msg.ValidateBasic()
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	return req.BaseReq, []sdk.Msg{msg}, nil
+}
+
+type jailReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Fee     string       `json:"fee"`
+}
+
+func jailMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
+	var req jailReq
+
+	// Get body parameters
+	if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse request")
+	}
+
+	var valAddr sdk.AccAddress
+	valAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		valAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, req.BaseReq.From)
+		if err != nil {
+			return rest.BaseReq{}, nil, fmt.Errorf("failed to parse sender address or name: %s", req.BaseReq.From)
+		}
+	}
+
+	req.BaseReq.From = valAddr.String()
+	if !req.BaseReq.ValidateBasic(w) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse base request")
+	}
+
 	fee, err := cliutil.ToBigsun(cliutil.Hdac(req.Fee))
 	if err != nil {
 		return rest.BaseReq{}, nil, err
 	}
 
 	// create the message
-	msg := types.NewMsgEditValidator("system:edit_validator", valAddr, req.Description, string(fee))
+	msg := types.NewMsgJail("system:jail", valAddr, string(fee))
+	err = msg.ValidateBasic()
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	return req.BaseReq, []sdk.Msg{msg}, nil
+}
+
+type unjailReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Fee     string       `json:"fee"`
+}
+
+func unjailMsgCreator(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (rest.BaseReq, []sdk.Msg, error) {
+	var req unjailReq
+
+	// Get body parameters
+	if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse request")
+	}
+
+	var valAddr sdk.AccAddress
+	valAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		valAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, req.BaseReq.From)
+		if err != nil {
+			return rest.BaseReq{}, nil, fmt.Errorf("failed to parse sender address or name: %s", req.BaseReq.From)
+		}
+	}
+
+	req.BaseReq.From = valAddr.String()
+	if !req.BaseReq.ValidateBasic(w) {
+		return rest.BaseReq{}, nil, fmt.Errorf("failed to parse base request")
+	}
+
+	fee, err := cliutil.ToBigsun(cliutil.Hdac(req.Fee))
+	if err != nil {
+		return rest.BaseReq{}, nil, err
+	}
+
+	// create the message
+	msg := types.NewMsgUnjail("system:unjail", valAddr, string(fee))
 	err = msg.ValidateBasic()
 	if err != nil {
 		return rest.BaseReq{}, nil, err
@@ -814,3 +1244,55 @@ func getVoterQuerying(w http.ResponseWriter, cliCtx context.CLIContext, r *http.
 
 	return bz, nil, cliCtx
 }
+
+// parseFeePayer returns the address of the fee payer, or nil if not given
+func parseFeePayer(cliCtx context.CLIContext, addressOrNickname string) (sdk.AccAddress, error) {
+	if addressOrNickname == "" {
+		return nil, nil
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(addressOrNickname)
+	if err != nil {
+		feePayer, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, addressOrNickname)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse fee payer address or name: %s", addressOrNickname)
+		}
+	}
+	return feePayer, nil
+}
+
+// parseAuthorization returns the account acted for, and the keys authorizing the deploy of the account.
+// The sender authorizes the deploy of another account as its associated key, unless the keys are given.
+func parseAuthorization(cliCtx context.CLIContext, senderAddr sdk.AccAddress, account string, keys []string) (sdk.AccAddress, []sdk.AccAddress, error) {
+	accountAddr := senderAddr
+	if account != "" {
+		var err error
+		accountAddr, err = sdk.AccAddressFromBech32(account)
+		if err != nil {
+			accountAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, account)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse account address or name: %s", account)
+			}
+		}
+	}
+
+	authorizationKeys := []sdk.AccAddress{}
+	for _, key := range keys {
+		keyAddr, err := sdk.AccAddressFromBech32(key)
+		if err != nil {
+			keyAddr, err = cliutil.GetAddress(cliCtx.Codec, cliCtx, key)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse authorization key address or name: %s", key)
+			}
+		}
+		authorizationKeys = append(authorizationKeys, keyAddr)
+	}
+
+	if len(authorizationKeys) == 0 {
+		if accountAddr.Equals(senderAddr) {
+			return accountAddr, nil, nil
+		}
+		authorizationKeys = append(authorizationKeys, senderAddr)
+	}
+	return accountAddr, authorizationKeys, nil
+}
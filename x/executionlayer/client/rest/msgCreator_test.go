@@ -81,7 +81,7 @@ func TestRESTContractRunContractAddress(t *testing.T) {
 		ExecutionType:                 "uref",
 		TokenContractAddressOrKeyName: "fridaycontracturef1v4xev2kdy8hkzvwcadk4a3872lzcyyz8t44du5z2jhz636qduz3sf9mf96",
 		Base64EncodedBinary:           "",
-		Args:                          `[{"name": "method", "value": {"string_value": "mint"}},{"name": "address", "value": {"string_value": "friday1qt8k20h3hmdx0qulgpppnlsg92hjjtvn59qkyd"}},{"name": "amount", "value": {"big_int": {"value": "100000", "bit_width": 512}}}]`,
+		Args:                          `[{"name": "method", "value": {"cl_type": {"simple_type": "STRING"}, "value": {"str_value": "mint"}}},{"name": "address", "value": {"cl_type": {"list_type": {"inner": {"simple_type": "U8"}}}, "value": {"bytes_value": "friday1gp2u22697kz6slwa25k2tkhz6st2l0zx3hkfc5wdlpjaauv5czsq2dwu8m"}}},{"name": "amount", "value": {"cl_type": {"simple_type": "U512"}, "value": {"u512": {"value": "100000"}}}}]`,
 		Fee:                           "10000000",
 	}
 
@@ -95,6 +95,69 @@ func TestRESTContractRunContractAddress(t *testing.T) {
 	require.NotNil(t, msgs)
 }
 
+func TestRESTContractRunInvalidArgs(t *testing.T) {
+	_, _, writer, clictx, basereq := prepare()
+
+	contractReq := contractRunReq{
+		BaseReq:                       basereq,
+		ExecutionType:                 "uref",
+		TokenContractAddressOrKeyName: "fridaycontracturef1v4xev2kdy8hkzvwcadk4a3872lzcyyz8t44du5z2jhz636qduz3sf9mf96",
+		Base64EncodedBinary:           "",
+		Args:                          `[{"name": "amount", "value": {"cl_type": {"simple_type": "U512"}, "value": {"str_value": "100000"}}}]`,
+		Fee:                           "10000000",
+	}
+
+	body := clictx.Codec.MustMarshalJSON(contractReq)
+	req := mustNewRequest(t, "POST", "/contract", bytes.NewReader(body))
+
+	_, _, err := contractRunMsgCreator(writer, clictx, req)
+	require.Error(t, err)
+}
+
+func TestRESTContractDeploy(t *testing.T) {
+	_, _, writer, clictx, basereq := prepare()
+
+	contractReq := contractDeployReq{
+		BaseReq:             basereq,
+		Name:                "counter",
+		Base64EncodedBinary: base64.StdEncoding.EncodeToString([]byte{0x00, 0x61, 0x73, 0x6d}),
+		Args:                "",
+		Metadata:            "counter contract",
+		Fee:                 "10000000",
+	}
+
+	body := clictx.Codec.MustMarshalJSON(contractReq)
+	req := mustNewRequest(t, "POST", "/contract/deploy", bytes.NewReader(body))
+
+	outputBasereq, msgs, err := contractDeployMsgCreator(writer, clictx, req)
+	require.NoError(t, err)
+	require.Equal(t, outputBasereq, basereq)
+	require.Len(t, msgs, 1)
+
+	contractReq.Name = "Invalid Name"
+	body = clictx.Codec.MustMarshalJSON(contractReq)
+	req = mustNewRequest(t, "POST", "/contract/deploy", bytes.NewReader(body))
+
+	_, _, err = contractDeployMsgCreator(writer, clictx, req)
+	require.Error(t, err)
+}
+
+func TestRESTContractRegistry(t *testing.T) {
+	fromAddr, _, writer, clictx, _ := prepare()
+
+	req := mustNewRequest(t, "GET", fmt.Sprintf("/%s/registry?name=%s", general, "counter"), nil)
+	res, route, err := getContractRegistryQuerying(writer, clictx, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "querycontract", route)
+
+	req = mustNewRequest(t, "GET", fmt.Sprintf("/%s/registry?deployer=%s", general, fromAddr), nil)
+	res, route, err = getContractRegistryQuerying(writer, clictx, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, "querycontracts", route)
+}
+
 func TestRESTContractQuery(t *testing.T) {
 	_, _, writer, clictx, _ := prepare()
 
@@ -128,6 +191,98 @@ func TestRESTTransfer(t *testing.T) {
 	require.NotNil(t, msgs)
 }
 
+func TestRESTMultiTransfer(t *testing.T) {
+	fromAddr, receipAddr, writer, clictx, basereq := prepare()
+
+	// Body
+	transReq := multiTransferReq{
+		BaseReq: basereq,
+		Outputs: []transferOutputReq{
+			{RecipientAddressOrNickname: receipAddr, Amount: "20000000"},
+			{RecipientAddressOrNickname: fromAddr, Amount: "10000000"},
+		},
+		Fee: "10000000",
+	}
+
+	// http.request
+	body := clictx.Codec.MustMarshalJSON(transReq)
+	req := mustNewRequest(t, "POST", fmt.Sprintf("/%s/transfer_batch", hdacSpecific), bytes.NewReader(body))
+
+	outputBasereq, msgs, err := multiTransferMsgCreator(writer, clictx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, outputBasereq, basereq)
+	require.Len(t, msgs, 1)
+	require.Len(t, msgs[0].(types.MsgMultiTransfer).Outputs, 2)
+}
+
+func TestRESTTransferFeePayer(t *testing.T) {
+	fromAddr, receipAddr, writer, clictx, basereq := prepare()
+
+	// Body
+	transReq := transferReq{
+		BaseReq:                    basereq,
+		RecipientAddressOrNickname: receipAddr,
+		Amount:                     "20000000",
+		Fee:                        "10000000",
+		FeePayer:                   receipAddr,
+	}
+
+	// http.request
+	body := clictx.Codec.MustMarshalJSON(transReq)
+	req := mustNewRequest(t, "POST", fmt.Sprintf("/%s/transfer", hdacSpecific), bytes.NewReader(body))
+
+	_, msgs, err := transferMsgCreator(writer, clictx, req)
+
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, []string{fromAddr, receipAddr}, []string{msgs[0].GetSigners()[0].String(), msgs[0].GetSigners()[1].String()})
+}
+
+func TestRESTAssociatedKeys(t *testing.T) {
+	fromAddr, receipAddr, writer, clictx, basereq := prepare()
+
+	// Body
+	keyReq := associatedKeyReq{
+		BaseReq:                        basereq,
+		AssociatedKeyAddressOrNickname: receipAddr,
+		Weight:                         2,
+		Fee:                            "10000000",
+	}
+
+	// http.request
+	body := clictx.Codec.MustMarshalJSON(keyReq)
+	req := mustNewRequest(t, "POST", fmt.Sprintf("/%s/associated_keys", hdacSpecific), bytes.NewReader(body))
+
+	outputBasereq, msgs, err := associatedKeyMsgCreator(true, writer, clictx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, outputBasereq, basereq)
+	require.Len(t, msgs, 1)
+	require.Equal(t, uint8(2), msgs[0].(types.MsgAddAssociatedKey).Weight)
+
+	// The associated key transfers from the account, which co-signs the tx
+	transReq := transferReq{
+		BaseReq:                    basereq,
+		RecipientAddressOrNickname: receipAddr,
+		Amount:                     "20000000",
+		Fee:                        "10000000",
+		Account:                    receipAddr,
+	}
+
+	body = clictx.Codec.MustMarshalJSON(transReq)
+	req = mustNewRequest(t, "POST", fmt.Sprintf("/%s/transfer", hdacSpecific), bytes.NewReader(body))
+
+	_, msgs, err = transferMsgCreator(writer, clictx, req)
+
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, receipAddr, msgs[0].(types.MsgTransfer).FromAddress.String())
+	require.Len(t, msgs[0].GetSigners(), 2)
+	require.Equal(t, receipAddr, msgs[0].GetSigners()[0].String())
+	require.Equal(t, fromAddr, msgs[0].GetSigners()[1].String())
+}
+
 func TestRESTBond(t *testing.T) {
 	_, _, writer, clictx, basereq := prepare()
 
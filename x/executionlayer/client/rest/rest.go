@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net/http"
 
@@ -10,10 +11,13 @@ import (
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/storedvalue"
 	"github.com/hdac-io/friday/client/context"
+	sdk "github.com/hdac-io/friday/types"
 	"github.com/hdac-io/friday/types/rest"
 	"github.com/hdac-io/friday/x/auth/client/utils"
 	cliutil "github.com/hdac-io/friday/x/executionlayer/client/util"
 	"github.com/hdac-io/friday/x/executionlayer/types"
+	govrest "github.com/hdac-io/friday/x/gov/client/rest"
+	govtypes "github.com/hdac-io/friday/x/gov/types"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -25,8 +29,15 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 
 	r.HandleFunc(fmt.Sprintf("/%s", general), contractRunHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s", general), contractQueryHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/deploy", general), contractDeployHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/registry", general), contractRegistryHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/upgrade", general), getEngineUpgradeHandler(cliCtx, storeName)).Methods("GET")
 
 	r.HandleFunc(fmt.Sprintf("/%s/transfer", hdacSpecific), transferHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/transfer_batch", hdacSpecific), multiTransferHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/associated_keys", hdacSpecific), addAssociatedKeyHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/associated_keys", hdacSpecific), removeAssociatedKeyHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc(fmt.Sprintf("/%s/action_thresholds", hdacSpecific), setActionThresholdsHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/bond", hdacSpecific), bondHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/unbond", hdacSpecific), unbondHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/delegate", hdacSpecific), delegateHandler(cliCtx)).Methods("POST")
@@ -42,8 +53,84 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	r.HandleFunc(fmt.Sprintf("/%s/balance", hdacSpecific), getBalanceHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/stake", hdacSpecific), getStakeHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/validators", hdacSpecific), getValidatorHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/validator_set", hdacSpecific), getValidatorSetHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/validators", hdacSpecific), createValidatorHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/validators", hdacSpecific), editValidatorHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/jail", hdacSpecific), jailHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/unjail", hdacSpecific), unjailHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/signing_info", hdacSpecific), getSigningInfoHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/slashes", hdacSpecific), getSlashesHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/params", hdacSpecific), getParamsHandler(cliCtx, storeName)).Methods("GET")
+}
+
+// writeGenerateStdTxResponse writes the simulation of the messages on the execution engine
+// if simulate is requested, otherwise the unsigned tx of the messages
+func writeGenerateStdTxResponse(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq, msgs []sdk.Msg) {
+	if !baseReq.Simulate {
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		return
+	}
+
+	results, err := cliutil.SimulateMsgs(cliCtx, msgs)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	rest.PostProcessResponseBare(w, cliCtx, results)
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the engine upgrade REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "engine_upgrade",
+		Handler:  postEngineUpgradeProposalHandlerFn(cliCtx),
+	}
+}
+
+func postEngineUpgradeProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req engineUpgradeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		installer, err := base64.StdEncoding.DecodeString(req.Base64EncodedInstaller)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to decode installer WASM binary")
+			return
+		}
+
+		content := types.NewEngineUpgradeProposal(req.Title, req.Description, req.Height, req.ProtocolVersion, req.WasmCosts, installer)
+
+		msg := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func getEngineUpgradeHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/queryupgrade", types.ModuleName))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		rest.PostProcessResponseBare(w, cliCtx, res)
+	}
 }
 
 func contractRunHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -53,12 +140,32 @@ func contractRunHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
 func contractQueryHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		if r.URL.Query().Get("decode") == "true" {
+			bz, err := getContractStateQuerying(w, cliCtx, r)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querystate", types.ModuleName), bz)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			rest.PostProcessResponseBare(w, cliCtx, res)
+			return
+		}
+
 		bz, path, err := getContractQuerying(w, cliCtx, r)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -66,10 +173,14 @@ func contractQueryHandler(cliCtx context.CLIContext, storeName string) http.Hand
 		}
 
 		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querydetail", types.ModuleName), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		var storedValue storedvalue.StoredValue
 		storedValue, err, _ = storedValue.FromBytes(res)
 		if err != nil {
-			fmt.Printf("could not resolve data - %s\nerr : %s\n", path, err.Error())
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("could not resolve data - %s, %s", path, err.Error()))
 			return
 		}
 		marshaler := jsonpb.Marshaler{Indent: "  "}
@@ -89,7 +200,7 @@ func contractQueryHandler(cliCtx context.CLIContext, storeName string) http.Hand
 		}
 
 		if err != nil {
-			fmt.Printf("could not resolve data - %s\nerr : %s\n", path, err.Error())
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("could not resolve data - %s, %s", path, err.Error()))
 			return
 		}
 
@@ -99,6 +210,39 @@ func contractQueryHandler(cliCtx context.CLIContext, storeName string) http.Hand
 	}
 }
 
+func contractDeployHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		baseReq, msgs, err := contractDeployMsgCreator(w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+	}
+}
+
+func contractRegistryHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, route, err := getContractRegistryQuerying(w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, route), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		rest.PostProcessResponseBare(w, cliCtx, res)
+	}
+}
+
 func transferHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		baseReq, msgs, err := transferMsgCreator(w, cliCtx, r)
@@ -106,7 +250,51 @@ func transferHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+	}
+}
+
+func multiTransferHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		baseReq, msgs, err := multiTransferMsgCreator(w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+	}
+}
+
+func addAssociatedKeyHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		baseReq, msgs, err := associatedKeyMsgCreator(true, w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+	}
+}
+
+func removeAssociatedKeyHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		baseReq, msgs, err := associatedKeyMsgCreator(false, w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+	}
+}
+
+func setActionThresholdsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		baseReq, msgs, err := actionThresholdsMsgCreator(w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
@@ -117,7 +305,7 @@ func bondHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
@@ -128,7 +316,7 @@ func unbondHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
@@ -139,7 +327,7 @@ func delegateHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
@@ -150,7 +338,7 @@ func undelegateHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
@@ -161,7 +349,7 @@ func redelegateHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
@@ -172,7 +360,7 @@ func voteHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
@@ -183,7 +371,7 @@ func unvoteHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
@@ -194,12 +382,17 @@ func claimHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
 func getBalanceHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		bz, err, cliCtx := getBalanceQuerying(w, cliCtx, r, storeName)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -217,6 +410,11 @@ func getBalanceHandler(cliCtx context.CLIContext, storeName string) http.Handler
 
 func getStakeHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		bz, err, cliCtx := getStakeQuerying(w, cliCtx, r, storeName)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -234,6 +432,11 @@ func getStakeHandler(cliCtx context.CLIContext, storeName string) http.HandlerFu
 
 func getVoteHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		bz, err, cliCtx := getVoteQuerying(w, cliCtx, r, storeName)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -256,7 +459,7 @@ func createValidatorHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
 	}
 }
 
@@ -267,12 +470,119 @@ func editValidatorHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+	}
+}
+
+func jailHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		baseReq, msgs, err := jailMsgCreator(w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+	}
+}
+
+func unjailHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		baseReq, msgs, err := unjailMsgCreator(w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateStdTxResponse(w, cliCtx, baseReq, msgs)
+	}
+}
+
+func getSigningInfoHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err, cliCtx := getValidatorQuerying(w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(bz) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "address is required")
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/querysigninginfo", storeName), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		rest.PostProcessResponseBare(w, cliCtx, res)
+	}
+}
+
+func getSlashesHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err, cliCtx := getValidatorQuerying(w, cliCtx, r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/queryslashes", storeName), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		rest.PostProcessResponseBare(w, cliCtx, res)
+	}
+}
+
+func getParamsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/queryparams", storeName))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		rest.PostProcessResponseBare(w, cliCtx, res)
+	}
+}
+
+func getValidatorSetHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/queryvalidatorset", storeName))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		rest.PostProcessResponseBare(w, cliCtx, res)
 	}
 }
 
 func getValidatorHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		bz, err, cliCtx := getValidatorQuerying(w, cliCtx, r)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -296,6 +606,11 @@ func getValidatorHandler(cliCtx context.CLIContext, storeName string) http.Handl
 
 func getDelegatorHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		bz, err, cliCtx := getDelegatorQuerying(w, cliCtx, r)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -314,6 +629,11 @@ func getDelegatorHandler(cliCtx context.CLIContext, storeName string) http.Handl
 
 func getVoterHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		bz, err, cliCtx := getVoterQuerying(w, cliCtx, r)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -332,6 +652,11 @@ func getVoterHandler(cliCtx context.CLIContext, storeName string) http.HandlerFu
 
 func getRewardHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		bz, err, cliCtx := getRewardQuerying(w, cliCtx, r, storeName)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -350,6 +675,11 @@ func getRewardHandler(cliCtx context.CLIContext, storeName string) http.HandlerF
 
 func getCommissionHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		bz, err, cliCtx := getCommissionQuerying(w, cliCtx, r, storeName)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/hdac-io/friday/codec"
 	"github.com/hdac-io/friday/crypto/keys"
 	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer/types"
 	idtype "github.com/hdac-io/friday/x/nickname/types"
 )
 
@@ -36,6 +38,117 @@ func GetAddress(cdc *codec.Codec, cliCtx context.CLIContext, addressOrName strin
 	return address, nil
 }
 
+// GetRecord returns the record of the key on the nickname
+func GetRecord(cdc *codec.Codec, cliCtx context.CLIContext, name, key string) (string, error) {
+	queryData := idtype.QueryReqRecords{
+		Nickname: name,
+		Key:      key,
+	}
+	bz := cdc.MustMarshalJSON(queryData)
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/getrecords", idtype.StoreKey), bz)
+	if err != nil {
+		return "", err
+	}
+	var out idtype.QueryResRecords
+	cdc.MustUnmarshalJSON(res, &out)
+	if len(out.Records) == 0 {
+		return "", fmt.Errorf("no record %s on nickname %s", key, name)
+	}
+	return out.Records[0].Value, nil
+}
+
+// GetRecipientAddress searches the address the payments to the nickname go to,
+// which is its address record if set, or the owner of the nickname.
+func GetRecipientAddress(cdc *codec.Codec, cliCtx context.CLIContext, addressOrName string) (sdk.AccAddress, error) {
+	address, err := sdk.AccAddressFromBech32(addressOrName)
+	if err == nil {
+		return address, nil
+	}
+
+	if record, err := GetRecord(cdc, cliCtx, addressOrName, idtype.RecordKeyAddress); err == nil {
+		return sdk.AccAddressFromBech32(record)
+	}
+	return GetAddress(cdc, cliCtx, addressOrName)
+}
+
+// GetContractHashAddress parses the contract hash address, or searches it in the records of the nickname of the dapp
+func GetContractHashAddress(cdc *codec.Codec, cliCtx context.CLIContext, addressOrName string) (sdk.ContractHashAddress, error) {
+	address, err := sdk.ContractHashAddressFromBech32(addressOrName)
+	if err == nil {
+		return address, nil
+	}
+
+	record, recordErr := GetRecord(cdc, cliCtx, addressOrName, idtype.RecordKeyContractHash)
+	if recordErr != nil {
+		return nil, err
+	}
+	return sdk.ContractHashAddressFromBech32(record)
+}
+
+// GetContractUrefAddress parses the contract uref address, or searches it in the records of the nickname of the dapp
+func GetContractUrefAddress(cdc *codec.Codec, cliCtx context.CLIContext, addressOrName string) (sdk.ContractUrefAddress, error) {
+	address, err := sdk.ContractUrefAddressFromBech32(addressOrName)
+	if err == nil {
+		return address, nil
+	}
+
+	record, recordErr := GetRecord(cdc, cliCtx, addressOrName, idtype.RecordKeyContractUref)
+	if recordErr != nil {
+		return nil, err
+	}
+	return sdk.ContractUrefAddressFromBech32(record)
+}
+
+// SimulateMsgs simulates the executionlayer messages on the execution engine
+func SimulateMsgs(cliCtx context.CLIContext, msgs []sdk.Msg) ([]types.SimulateResult, error) {
+	results := make([]types.SimulateResult, 0, len(msgs))
+	for _, msg := range msgs {
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQuerySimulateParams(msg))
+		if err != nil {
+			return nil, err
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/simulate", types.ModuleName), bz)
+		if err != nil {
+			return nil, err
+		}
+
+		var result types.SimulateResult
+		if err := cliCtx.Codec.UnmarshalJSON(res, &result); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// NormalizeSessionArgs checks that the json session args can be encoded for the execution engine
+// and returns them compacted with sorted keys, the form in which bech32 addresses are resolved.
+func NormalizeSessionArgs(args string) (string, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+
+	var jsonData []map[string]interface{}
+	if err := json.Unmarshal([]byte(args), &jsonData); err != nil {
+		return "", err
+	}
+	normalized, err := json.Marshal(jsonData)
+	if err != nil {
+		return "", err
+	}
+
+	deployArgs, _, err := types.DeployArgsFromJSON(string(normalized))
+	if err != nil {
+		return "", err
+	}
+	if _, err := deployArgs.Encode(); err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
 func GetContractType(strContractType string) util.ContractType {
 	var contractType util.ContractType
 	switch strContractType {
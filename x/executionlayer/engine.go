@@ -0,0 +1,200 @@
+package executionlayer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hdac-io/tendermint/libs/log"
+
+	"github.com/hdac-io/friday/x/executionlayer/mock"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+)
+
+var _ ipc.ExecutionEngineServiceClient = &engineClient{}
+
+// engineClient bounds every call to the execution engine with a deadline and
+// retries with backoff while the engine is unavailable, e.g. while it restarts.
+type engineClient struct {
+	client ipc.ExecutionEngineServiceClient
+	config types.EngineConfig
+	logger log.Logger
+}
+
+// NewEngineClient returns the in-process mock engine for MockEngineAddress,
+// otherwise a client of the execution engine at the configured address.
+func NewEngineClient(config types.EngineConfig, logger log.Logger) ipc.ExecutionEngineServiceClient {
+	if err := config.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	if config.Address == types.MockEngineAddress {
+		return mock.NewExecutionEngine()
+	}
+
+	network, address, _ := config.Endpoint()
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, address)
+	}
+
+	// the connection is established lazily and re-established by grpc when the engine restarts
+	conn, err := grpc.Dial("passthrough:///"+address,
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialer),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  config.RetryBackoff,
+				Multiplier: backoff.DefaultConfig.Multiplier,
+				Jitter:     backoff.DefaultConfig.Jitter,
+				MaxDelay:   config.MaxRetryBackoff,
+			},
+			MinConnectTimeout: config.DialTimeout,
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return &engineClient{
+		client: ipc.NewExecutionEngineServiceClient(conn),
+		config: config,
+		logger: logger.With("module", fmt.Sprintf("x/%s", types.ModuleName)),
+	}
+}
+
+// isUnavailable reports whether the call failed because the engine could not be reached in time
+func isUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// call invokes fn with a per-call deadline and retries it while the engine is unavailable
+func (c *engineClient) call(ctx context.Context, method string, fn func(context.Context) error) error {
+	delay := c.config.RetryBackoff
+	for retry := 0; ; retry++ {
+		callCtx, cancel := ctx, func() {}
+		if c.config.CallTimeout > 0 {
+			callCtx, cancel = context.WithTimeout(ctx, c.config.CallTimeout)
+		}
+		err := fn(callCtx)
+		cancel()
+
+		if err == nil || !isUnavailable(err) || ctx.Err() != nil {
+			if err == nil && retry > 0 {
+				c.logger.Info("Execution engine is available again", "method", method, "retries", retry)
+			}
+			return err
+		}
+		if c.config.MaxRetries > 0 && retry >= c.config.MaxRetries {
+			c.logger.Error("Execution engine is unavailable, giving up", "method", method, "retries", retry, "err", err)
+			return err
+		}
+
+		c.logger.Error("Execution engine is unavailable, retrying", "method", method, "retry", retry+1, "backoff", delay, "err", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > c.config.MaxRetryBackoff {
+			delay = c.config.MaxRetryBackoff
+		}
+	}
+}
+
+// waitForReady makes a call wait for the connection within its deadline instead of failing fast
+func waitForReady(opts []grpc.CallOption) []grpc.CallOption {
+	return append([]grpc.CallOption{grpc.WaitForReady(true)}, opts...)
+}
+
+func (c *engineClient) Commit(ctx context.Context, in *ipc.CommitRequest, opts ...grpc.CallOption) (res *ipc.CommitResponse, err error) {
+	err = c.call(ctx, "Commit", func(ctx context.Context) (err error) {
+		res, err = c.client.Commit(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
+
+func (c *engineClient) Query(ctx context.Context, in *ipc.QueryRequest, opts ...grpc.CallOption) (res *ipc.QueryResponse, err error) {
+	err = c.call(ctx, "Query", func(ctx context.Context) (err error) {
+		res, err = c.client.Query(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
+
+func (c *engineClient) Execute(ctx context.Context, in *ipc.ExecuteRequest, opts ...grpc.CallOption) (res *ipc.ExecuteResponse, err error) {
+	err = c.call(ctx, "Execute", func(ctx context.Context) (err error) {
+		res, err = c.client.Execute(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
+
+func (c *engineClient) RunGenesis(ctx context.Context, in *ipc.ChainSpec_GenesisConfig, opts ...grpc.CallOption) (res *ipc.GenesisResponse, err error) {
+	err = c.call(ctx, "RunGenesis", func(ctx context.Context) (err error) {
+		res, err = c.client.RunGenesis(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
+
+func (c *engineClient) Upgrade(ctx context.Context, in *ipc.UpgradeRequest, opts ...grpc.CallOption) (res *ipc.UpgradeResponse, err error) {
+	err = c.call(ctx, "Upgrade", func(ctx context.Context) (err error) {
+		res, err = c.client.Upgrade(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
+
+func (c *engineClient) BidState(ctx context.Context, in *ipc.BidStateRequest, opts ...grpc.CallOption) (res *ipc.BidStateResponse, err error) {
+	err = c.call(ctx, "BidState", func(ctx context.Context) (err error) {
+		res, err = c.client.BidState(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
+
+func (c *engineClient) DistributeRewards(ctx context.Context, in *ipc.DistributeRewardsRequest, opts ...grpc.CallOption) (res *ipc.DistributeRewardsResponse, err error) {
+	err = c.call(ctx, "DistributeRewards", func(ctx context.Context) (err error) {
+		res, err = c.client.DistributeRewards(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
+
+func (c *engineClient) Slash(ctx context.Context, in *ipc.SlashRequest, opts ...grpc.CallOption) (res *ipc.SlashResponse, err error) {
+	err = c.call(ctx, "Slash", func(ctx context.Context) (err error) {
+		res, err = c.client.Slash(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
+
+func (c *engineClient) UnbondPayout(ctx context.Context, in *ipc.UnbondPayoutRequest, opts ...grpc.CallOption) (res *ipc.UnbondPayoutResponse, err error) {
+	err = c.call(ctx, "UnbondPayout", func(ctx context.Context) (err error) {
+		res, err = c.client.UnbondPayout(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
+
+func (c *engineClient) Step(ctx context.Context, in *ipc.StepRequest, opts ...grpc.CallOption) (res *ipc.StepResponse, err error) {
+	err = c.call(ctx, "Step", func(ctx context.Context) (err error) {
+		res, err = c.client.Step(ctx, in, waitForReady(opts)...)
+		return
+	})
+	return
+}
@@ -0,0 +1,57 @@
+package executionlayer
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
+	"github.com/hdac-io/tendermint/libs/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hdac-io/friday/x/executionlayer/mock"
+)
+
+func TestNewEngineClientMock(t *testing.T) {
+	client := NewEngineClient(NewEngineConfig(MockEngineAddress), log.NewNopLogger())
+	_, ok := client.(*mock.ExecutionEngine)
+	require.True(t, ok)
+
+	require.Panics(t, func() { NewEngineClient(NewEngineConfig("http://localhost"), log.NewNopLogger()) })
+}
+
+func TestEngineClientRetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// nothing listens on the socket
+	config := NewEngineConfig(filepath.Join(dir, "casper-node.sock"))
+	config.CallTimeout = 50 * time.Millisecond
+	config.MaxRetries = 2
+	config.RetryBackoff = 10 * time.Millisecond
+	config.MaxRetryBackoff = 20 * time.Millisecond
+	client := NewEngineClient(config, log.NewNopLogger())
+
+	start := time.Now()
+	_, err = client.Step(context.Background(), &ipc.StepRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// 3 calls and 2 backoffs
+	require.True(t, time.Since(start) >= 3*config.CallTimeout+30*time.Millisecond)
+
+	// a cancelled context stops retrying
+	config.MaxRetries = 0
+	client = NewEngineClient(config, log.NewNopLogger())
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err = client.Step(ctx, &ipc.StepRequest{})
+	require.Error(t, err)
+	require.Equal(t, context.DeadlineExceeded, ctx.Err())
+}
@@ -38,6 +38,13 @@ func InitGenesis(
 	candidateBlock := ctx.CandidateBlock()
 	candidateBlock.State = response.GetSuccess().PoststateHash
 
+	keeper.SetParams(ctx, data.Params)
+	for _, info := range data.SigningInfos {
+		keeper.SetValidatorSigningInfo(ctx, info.Address, info)
+	}
+	for _, record := range data.SlashRecords {
+		keeper.SetSlashRecord(ctx, record)
+	}
 	keeper.SetChainName(ctx, data.ChainName)
 	keeper.SetGenesisConf(ctx, data.GenesisConf)
 	keeper.SetUnitHashMap(ctx, types.NewUnitHashMap(ctx.CandidateBlock().State))
@@ -54,6 +61,18 @@ func InitGenesis(
 	validatorStakeInfos := posInfos.Contract.NamedKeys.GetAllValidators()
 	validatorUpdates := []abci.ValidatorUpdate{}
 
+	// only the validators ranked in the max validators are in the validator set at genesis,
+	// and jailed validators are out of it
+	genesisStakes := map[string]string{}
+	for _, validator := range data.Validators {
+		genesisStakes[hex.EncodeToString(validator.OperatorAddress)] = validator.Stake
+	}
+	active, _ := rankValidators(data.Validators, genesisStakes, data.Params.MaxValidators)
+	activeStakes := map[string]string{}
+	for _, validator := range active {
+		activeStakes[validator.OperatorAddress.String()] = validator.Stake
+	}
+
 	for _, validator := range data.Validators {
 		bond := &ipc.Bond{
 			ValidatorPublicKey: validator.OperatorAddress,
@@ -61,23 +80,23 @@ func InitGenesis(
 		}
 		bonds = append(bonds, bond)
 
-		// for export update
-		powerStr := validator.Stake
-		if len(powerStr) > types.DECIMAL_POINT_POS {
-			powerStr = powerStr[:len(powerStr)-types.DECIMAL_POINT_POS]
-		} else {
-			powerStr = "0"
-		}
-		power, err := strconv.ParseInt(powerStr, 10, 64)
-		if err != nil {
-			power = 0
-		}
-		validatorUpdate := abci.ValidatorUpdate{
-			PubKey: tmtypes.TM2PB.PubKey(validator.ConsPubKey),
-			Power:  power,
-		}
+		if powerStr, found := activeStakes[validator.OperatorAddress.String()]; found {
+			if len(powerStr) > types.DECIMAL_POINT_POS {
+				powerStr = powerStr[:len(powerStr)-types.DECIMAL_POINT_POS]
+			} else {
+				powerStr = "0"
+			}
+			power, err := strconv.ParseInt(powerStr, 10, 64)
+			if err != nil {
+				power = 0
+			}
+			validatorUpdate := abci.ValidatorUpdate{
+				PubKey: tmtypes.TM2PB.PubKey(validator.ConsPubKey),
+				Power:  power,
+			}
 
-		validatorUpdates = append(validatorUpdates, validatorUpdate)
+			validatorUpdates = append(validatorUpdates, validatorUpdate)
+		}
 
 		validator.Stake = ""
 		keeper.SetValidator(ctx, validator.OperatorAddress, validator)
@@ -120,8 +139,17 @@ func ExportGenesis(ctx sdk.Context, keeper ExecutionLayerKeeper) types.GenesisSt
 	protocolVersion := keeper.GetProtocolVersion(ctx)
 
 	stakeAmounts := map[string]string{}
+	var posStakes map[string]string
 	for _, validator := range validators {
-		stakeAmounts[validator.OperatorAddress.String()] = validator.Stake
+		stake := validator.Stake
+		// jailed validators have no power, but their stakes are still bonded
+		if validator.Jailed && len(stateHash) != 0 {
+			if posStakes == nil {
+				posStakes = queryPosStakes(ctx, keeper)
+			}
+			stake = posStakes[hex.EncodeToString(validator.OperatorAddress)]
+		}
+		stakeAmounts[validator.OperatorAddress.String()] = stake
 	}
 
 	accounts := []types.Account{}
@@ -163,7 +191,22 @@ func ExportGenesis(ctx sdk.Context, keeper ExecutionLayerKeeper) types.GenesisSt
 	}
 
 	return types.NewGenesisState(
-		keeper.GetGenesisConf(ctx), accounts, keeper.GetChainName(ctx), validators, stateInfos)
+		keeper.GetGenesisConf(ctx), accounts, keeper.GetChainName(ctx), validators, stateInfos, keeper.GetParams(ctx),
+		keeper.GetAllValidatorSigningInfos(ctx), keeper.GetAllSlashRecords(ctx))
+}
+
+// queryPosStakes returns the stakes of the validators bonded in the PoS contract
+func queryPosStakes(ctx sdk.Context, keeper ExecutionLayerKeeper) map[string]string {
+	resPosInfoBytes, err := getQueryResult(ctx, keeper, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	if err != nil {
+		panic(err)
+	}
+	var posInfos storedvalue.StoredValue
+	posInfos, err, _ = posInfos.FromBytes(resPosInfoBytes)
+	if err != nil {
+		panic(err)
+	}
+	return posInfos.Contract.NamedKeys.GetAllValidators()
 }
 
 func WriteValidators(ctx sdk.Context, keeper ExecutionLayerKeeper) (vals []tmtypes.GenesisValidator) {
@@ -171,7 +214,9 @@ func WriteValidators(ctx sdk.Context, keeper ExecutionLayerKeeper) (vals []tmtyp
 
 	for _, validator := range validators {
 		powerStr := validator.Stake
-		if len(powerStr) > types.DECIMAL_POINT_POS {
+		if validator.Jailed {
+			powerStr = "0"
+		} else if len(powerStr) > types.DECIMAL_POINT_POS {
 			powerStr = powerStr[:len(powerStr)-types.DECIMAL_POINT_POS]
 		} else {
 			powerStr = "0"
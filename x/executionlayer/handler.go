@@ -3,15 +3,15 @@ package executionlayer
 import (
 	"encoding/hex"
 	"fmt"
-	"strings"
+	"strconv"
 
-	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc/transforms"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
 	sdk "github.com/hdac-io/friday/types"
 	"github.com/hdac-io/friday/x/executionlayer/types"
+	govtypes "github.com/hdac-io/friday/x/gov/types"
 	"github.com/hdac-io/tendermint/libs/common"
 	tmtypes "github.com/hdac-io/tendermint/types"
 )
@@ -21,35 +21,53 @@ func NewHandler(k ExecutionLayerKeeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg, simulate bool, txIndex int, msgIndex int) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
+		var res sdk.Result
 		switch msg := msg.(type) {
 		case types.MsgExecute:
-			return handlerMsgExecute(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgExecute(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgTransfer:
-			return handlerMsgTransfer(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgTransfer(ctx, k, msg, simulate, txIndex, msgIndex)
+		case types.MsgMultiTransfer:
+			res = handlerMsgMultiTransfer(ctx, k, msg, simulate, txIndex, msgIndex)
+		case types.MsgAddAssociatedKey:
+			res = handlerMsgAddAssociatedKey(ctx, k, msg, simulate, txIndex, msgIndex)
+		case types.MsgRemoveAssociatedKey:
+			res = handlerMsgRemoveAssociatedKey(ctx, k, msg, simulate, txIndex, msgIndex)
+		case types.MsgSetActionThresholds:
+			res = handlerMsgSetActionThresholds(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgCreateValidator:
-			return handlerMsgCreateValidator(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgCreateValidator(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgEditValidator:
-			return handlerMsgEditValidator(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgEditValidator(ctx, k, msg, simulate, txIndex, msgIndex)
+		case types.MsgJail:
+			res = handlerMsgJail(ctx, k, msg, simulate, txIndex, msgIndex)
+		case types.MsgUnjail:
+			res = handlerMsgUnjail(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgBond:
-			return handlerMsgBond(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgBond(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgUnBond:
-			return handlerMsgUnBond(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgUnBond(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgDelegate:
-			return handlerMsgDelegate(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgDelegate(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgUndelegate:
-			return handlerMsgUndelgate(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgUndelgate(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgRedelegate:
-			return handlerMsgRedelegate(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgRedelegate(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgVote:
-			return handlerMsgVote(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgVote(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgUnvote:
-			return handlerMsgUnvote(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgUnvote(ctx, k, msg, simulate, txIndex, msgIndex)
 		case types.MsgClaim:
-			return handlerMsgClaim(ctx, k, msg, simulate, txIndex, msgIndex)
+			res = handlerMsgClaim(ctx, k, msg, simulate, txIndex, msgIndex)
+		case types.MsgDeployContract:
+			res = handlerMsgDeployContract(ctx, k, msg, simulate, txIndex, msgIndex)
 		default:
 			errMsg := fmt.Sprintf("unrecognized execution layer messgae type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
 		}
+
+		res.Events = append(res.Events, ctx.EventManager().Events()...)
+		return res
 	}
 }
 
@@ -60,30 +78,13 @@ func NewHandler(k ExecutionLayerKeeper) sdk.Handler {
 //   2) Fixed transfer & payment WASMs are needed
 func handlerMsgTransfer(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgTransfer, simulate bool, txIndex int, msgIndex int) sdk.Result {
 	proxyContractHash := k.GetProxyContractHash(ctx)
-	sessionArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.TransferMethodName}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_ListType{ListType: &state.CLType_List{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U8}}}}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_BytesValue{
-						BytesValue: msg.ToAddress}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_U512{
-						U512: &state.CLValueInstance_U512{
-							Value: msg.Amount}}}}}}
-
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.TransferMethodName).
+			Add("", types.BytesValue(msg.ToAddress)).
+			Add("", types.U512Value(msg.Amount)))
 	if err != nil {
-		getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
 	msgExecute := NewMsgExecute(
@@ -91,65 +92,241 @@ func handlerMsgTransfer(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgTr
 		msg.FromAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
-	if !simulate && result == true {
+	msgExecute.FeePayer = msg.FeePayer
+	msgExecute.AuthorizationKeys = msg.AuthorizationKeys
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+	if !simulate && deployResult.Success {
 		k.SetAccountIfNotExists(ctx, msg.ToAddress)
 	}
-	return getResult(result, log)
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransfer,
+				sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
-// Handle MsgExecute
-func handlerMsgExecute(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgExecute, simulate bool, txIndex int, msgIndex int) sdk.Result {
-	replacedSessionArgs, addrList, err := ReplaceFromBech32ToHex(msg.SessionArgs)
+// Handle MsgMultiTransfer
+// All the transfers are executed in a single deploy of the proxy contract, so they are all or nothing.
+func handlerMsgMultiTransfer(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgMultiTransfer, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	proxyContractHash := k.GetProxyContractHash(ctx)
+	recipients := []*state.CLValueInstance{}
+	amounts := []*state.CLValueInstance{}
+	for _, output := range msg.Outputs {
+		recipients = append(recipients, types.KeyValue(types.AccountKey(output.ToAddress)))
+		amounts = append(amounts, types.U512Value(output.Amount))
+	}
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.MultiTransferMethodName).
+			Add("", types.ListValue(types.SimpleType(state.CLType_KEY), recipients...)).
+			Add("", types.ListValue(types.SimpleType(state.CLType_U512), amounts...)))
 	if err != nil {
-		return getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
-	for _, unitAddr := range addrList {
-		k.SetAccountIfNotExists(ctx, unitAddr)
+	msgExecute := NewMsgExecute(
+		msg.ContractAddress,
+		msg.FromAddress,
+		util.HASH,
+		proxyContractHash,
+		sessionArgs,
+		msg.Fee,
+	)
+	msgExecute.FeePayer = msg.FeePayer
+	msgExecute.AuthorizationKeys = msg.AuthorizationKeys
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+	if !simulate && deployResult.Success {
+		for _, output := range msg.Outputs {
+			k.SetAccountIfNotExists(ctx, output.ToAddress)
+		}
+	}
+	if deployResult.Success {
+		for _, output := range msg.Outputs {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeTransfer,
+					sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+					sdk.NewAttribute(types.AttributeKeyRecipient, output.ToAddress.String()),
+					sdk.NewAttribute(types.AttributeKeyAmount, output.Amount),
+					sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+				),
+			)
+		}
 	}
+	return getDeployResult(deployResult)
+}
 
-	deployArgs, err := util.JsonStringToDeployArgs(replacedSessionArgs)
+// Handle MsgAddAssociatedKey
+func handlerMsgAddAssociatedKey(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgAddAssociatedKey, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.AddAssociatedKeyMethodName).
+			Add("", types.BytesValue(msg.AssociatedKey)).
+			Add("", types.U8Value(msg.Weight)))
 	if err != nil {
-		return getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
+	}
+
+	deployResult := executeKeyManagement(ctx, k, msg.ContractAddress, msg.AccountAddress, sessionArgs, msg.Fee, msg.AuthorizationKeys, simulate, txIndex, msgIndex)
+	// the associated key signs txs of the account, so it must be an account as well
+	if !simulate && deployResult.Success {
+		k.SetAccountIfNotExists(ctx, msg.AssociatedKey)
 	}
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAddAssociatedKey,
+				sdk.NewAttribute(types.AttributeKeySender, msg.AccountAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAssociatedKey, msg.AssociatedKey.String()),
+				sdk.NewAttribute(types.AttributeKeyWeight, fmt.Sprint(msg.Weight)),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
+}
 
-	deployAbi, err := util.AbiDeployArgsTobytes(deployArgs)
+// Handle MsgRemoveAssociatedKey
+func handlerMsgRemoveAssociatedKey(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgRemoveAssociatedKey, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.RemoveAssociatedKeyMethodName).
+			Add("", types.BytesValue(msg.AssociatedKey)))
 	if err != nil {
-		return getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
-	msg.SessionArgs = util.EncodeToHexString(deployAbi)
+	deployResult := executeKeyManagement(ctx, k, msg.ContractAddress, msg.AccountAddress, sessionArgs, msg.Fee, msg.AuthorizationKeys, simulate, txIndex, msgIndex)
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveAssociatedKey,
+				sdk.NewAttribute(types.AttributeKeySender, msg.AccountAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAssociatedKey, msg.AssociatedKey.String()),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
+}
 
-	result, log := execute(ctx, k, msg, simulate, txIndex, msgIndex)
-	if !simulate && result == true {
+// Handle MsgSetActionThresholds
+func handlerMsgSetActionThresholds(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgSetActionThresholds, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.SetActionThresholdsMethodName).
+			Add("", types.U8Value(msg.DeploymentThreshold)).
+			Add("", types.U8Value(msg.KeyManagementThreshold)))
+	if err != nil {
+		processDone(ctx, simulate)
+		return err.Result()
+	}
+
+	deployResult := executeKeyManagement(ctx, k, msg.ContractAddress, msg.AccountAddress, sessionArgs, msg.Fee, msg.AuthorizationKeys, simulate, txIndex, msgIndex)
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetActionThresholds,
+				sdk.NewAttribute(types.AttributeKeySender, msg.AccountAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyDeploymentThreshold, fmt.Sprint(msg.DeploymentThreshold)),
+				sdk.NewAttribute(types.AttributeKeyKeyManagementThreshold, fmt.Sprint(msg.KeyManagementThreshold)),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
+}
+
+// executeKeyManagement executes the key management method of the proxy contract on the account
+func executeKeyManagement(
+	ctx sdk.Context, k ExecutionLayerKeeper, contractAddress string, accountAddress sdk.AccAddress, sessionArgs string, fee string,
+	authorizationKeys []sdk.AccAddress, simulate bool, txIndex int, msgIndex int,
+) types.DeployResult {
+	msgExecute := NewMsgExecute(
+		contractAddress,
+		accountAddress,
+		util.HASH,
+		k.GetProxyContractHash(ctx),
+		sessionArgs,
+		fee,
+	)
+	msgExecute.AuthorizationKeys = authorizationKeys
+	return execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+}
+
+// Handle MsgExecute
+func handlerMsgExecute(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgExecute, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	deployArgs, addrList, err := types.DeployArgsFromJSON(msg.SessionArgs)
+	if err != nil {
+		processDone(ctx, simulate)
+		return types.ErrInvalidDeployArgs(types.DefaultCodespace, err.Error()).Result()
+	}
+
+	for _, unitAddr := range addrList {
+		k.SetAccountIfNotExists(ctx, unitAddr)
+	}
+
+	sessionArgs, sdkErr := encodeSessionArgs(deployArgs)
+	if sdkErr != nil {
+		processDone(ctx, simulate)
+		return sdkErr.Result()
+	}
+	msg.SessionArgs = sessionArgs
+
+	deployResult := execute(ctx, k, msg, simulate, txIndex, msgIndex)
+	if !simulate && deployResult.Success {
 		for _, unitAddr := range addrList {
 			k.SetAccountIfNotExists(ctx, unitAddr)
 		}
 	}
-	return getResult(result, log)
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecute,
+				sdk.NewAttribute(types.AttributeKeySender, msg.ExecAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyContract, contractString(msg.SessionType, msg.SessionCode)),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
 func handlerMsgCreateValidator(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgCreateValidator, simulate bool, txIndex int, msgIndex int) sdk.Result {
 
 	if _, found := k.GetValidator(ctx, msg.ValidatorAddress); found {
+		processDone(ctx, simulate)
 		return ErrValidatorOwnerExists(types.DefaultCodespace).Result()
 	}
 
 	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(msg.ConsPubKey)); found {
+		processDone(ctx, simulate)
 		return ErrValidatorPubKeyExists(types.DefaultCodespace).Result()
 	}
 
 	if _, err := msg.Description.EnsureLength(); err != nil {
+		processDone(ctx, simulate)
+		return err.Result()
+	}
+
+	if err := checkCommissionRate(ctx, k, msg.CommissionRate); err != nil {
+		processDone(ctx, simulate)
 		return err.Result()
 	}
 
 	if ctx.ConsensusParams() != nil {
 		tmPubKey := tmtypes.TM2PB.PubKey(msg.ConsPubKey)
 		if !common.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
+			processDone(ctx, simulate)
 			return ErrValidatorPubKeyTypeNotSupported(types.DefaultCodespace,
 				tmPubKey.Type,
 				ctx.ConsensusParams().Validator.PubKeyTypes).Result()
@@ -158,34 +335,58 @@ func handlerMsgCreateValidator(ctx sdk.Context, k ExecutionLayerKeeper, msg type
 
 	proxyContractHash := k.GetProxyContractHash(ctx)
 	validator := types.NewValidator(msg.ValidatorAddress, msg.ConsPubKey, msg.Description, "")
+	validator.CommissionRate = msg.CommissionRate
 
+	result := getResult(true, "")
 	if proxyContractHash != nil {
 
 		paymentAmount := types.BASIC_PAY_AMOUNT
 
-		sessionAbi, parseError := getPayAmountSessionArgsStr(paymentAmount)
+		sessionArgs, parseError := getPayAmountSessionArgsStr(paymentAmount)
+		if parseError != nil {
+			processDone(ctx, simulate)
+			return parseError.Result()
+		}
 
 		msgExecute := NewMsgExecute(
 			msg.ContractAddress,
 			msg.ValidatorAddress,
 			util.HASH,
 			proxyContractHash,
-			hex.EncodeToString(sessionAbi),
+			sessionArgs,
 			msg.Fee,
 		)
 
-		result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
-
-		if parseError != nil {
-			return getResult(false, parseError.Error())
-		} else if log != "" || !result {
-			return getResult(false, log)
+		deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+		if !deployResult.Success {
+			return getDeployResult(deployResult)
 		}
+		result = getDeployResult(deployResult)
+	} else {
+		// no deploy releases the candidate block
+		processDone(ctx, simulate)
 	}
 
 	k.SetValidator(ctx, msg.ValidatorAddress, validator)
 	k.SetValidatorByConsAddr(ctx, validator)
-	return getResult(true, "")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+		),
+	)
+	return result
+}
+
+// checkCommissionRate checks that the commission rate is within the bounds in the params
+func checkCommissionRate(ctx sdk.Context, k ExecutionLayerKeeper, rate sdk.Dec) sdk.Error {
+	min, max := k.MinCommissionRate(ctx), k.MaxCommissionRate(ctx)
+	if rate.IsNil() || rate.LT(min) || rate.GT(max) {
+		return types.ErrInvalidCommissionRate(types.DefaultCodespace, rate, min, max)
+	}
+	return nil
 }
 
 func handlerMsgEditValidator(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgEditValidator, simulate bool, txIndex int, msgIndex int) sdk.Result {
@@ -195,60 +396,137 @@ func handlerMsgEditValidator(ctx sdk.Context, k ExecutionLayerKeeper, msg types.
 
 	// replace all editable fields (clients should autofill existing values)
 	description, err := validator.Description.UpdateDescription(msg.Description)
+	var rateErr sdk.Error
+	if msg.CommissionRate != nil {
+		rateErr = checkCommissionRate(ctx, k, *msg.CommissionRate)
+	}
 
 	paymentAmount := "0"
-	if found && err == nil {
+	if found && err == nil && rateErr == nil {
 		paymentAmount = types.BASIC_PAY_AMOUNT
 	}
 
-	sessionAbi, parseError := getPayAmountSessionArgsStr(paymentAmount)
+	sessionArgs, parseError := getPayAmountSessionArgsStr(paymentAmount)
 
 	msgExecute := NewMsgExecute(
 		msg.ContractAddress,
 		msg.ValidatorAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
 
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
 
 	if !found {
-		return getResult(false, "validator does not exist for that address")
+		return types.ErrNoValidatorFound(types.DefaultCodespace).Result()
 	} else if err != nil {
-		return getResult(false, err.Error())
+		return err.Result()
+	} else if rateErr != nil {
+		return rateErr.Result()
 	} else if parseError != nil {
-		return getResult(false, parseError.Error())
-	} else if log != "" || !result {
-		return getResult(false, log)
+		return parseError.Result()
+	} else if !deployResult.Success {
+		return getDeployResult(deployResult)
 	}
 
 	validator.Description = description
+	if msg.CommissionRate != nil {
+		validator.CommissionRate = *msg.CommissionRate
+	}
 	k.SetValidator(ctx, msg.ValidatorAddress, validator)
-	return getResult(true, "")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEditValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+		),
+	)
+	return getDeployResult(deployResult)
+}
+
+func handlerMsgJail(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgJail, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		processDone(ctx, simulate)
+		return types.ErrNoValidatorFound(types.DefaultCodespace).Result()
+	}
+	if validator.Jailed {
+		processDone(ctx, simulate)
+		return types.ErrValidatorJailed(types.DefaultCodespace, msg.ValidatorAddress).Result()
+	}
+
+	deployResult, err := executePayment(ctx, k, msg.ContractAddress, msg.ValidatorAddress, msg.Fee, simulate, txIndex, msgIndex)
+	if err != nil {
+		return err.Result()
+	} else if !deployResult.Success {
+		return getDeployResult(deployResult)
+	}
+
+	k.jailValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJail,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+		),
+	)
+	return getDeployResult(deployResult)
+}
+
+func handlerMsgUnjail(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgUnjail, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		processDone(ctx, simulate)
+		return types.ErrNoValidatorFound(types.DefaultCodespace).Result()
+	}
+	if !validator.Jailed {
+		processDone(ctx, simulate)
+		return types.ErrValidatorNotJailed(types.DefaultCodespace, msg.ValidatorAddress).Result()
+	}
+	if info, found := k.GetValidatorSigningInfo(ctx, validator.ConsAddress()); found {
+		// cannot be unjailed if tombstoned
+		if info.Tombstoned {
+			processDone(ctx, simulate)
+			return types.ErrValidatorTombstoned(types.DefaultCodespace, msg.ValidatorAddress).Result()
+		}
+		// cannot be unjailed until out of jail
+		if ctx.BlockHeader().Time.Before(info.JailedUntil) {
+			processDone(ctx, simulate)
+			return types.ErrValidatorJailedFor(types.DefaultCodespace, msg.ValidatorAddress, info.JailedUntil).Result()
+		}
+	}
+
+	deployResult, err := executePayment(ctx, k, msg.ContractAddress, msg.ValidatorAddress, msg.Fee, simulate, txIndex, msgIndex)
+	if err != nil {
+		return err.Result()
+	} else if !deployResult.Success {
+		return getDeployResult(deployResult)
+	}
+
+	k.unjailValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+		),
+	)
+	return getDeployResult(deployResult)
 }
 
 func handlerMsgBond(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgBond, simulate bool, txIndex int, msgIndex int) sdk.Result {
 	proxyContractHash := k.GetProxyContractHash(ctx)
-	sessionArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.BondMethodName}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_U512{
-						U512: &state.CLValueInstance_U512{
-							Value: msg.Amount}}}}}}
-
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.BondMethodName).
+			Add("", types.U512Value(msg.Amount)))
 	if err != nil {
-		getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
 	msgExecute := NewMsgExecute(
@@ -256,38 +534,31 @@ func handlerMsgBond(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgBond,
 		msg.FromAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
-	return getResult(result, log)
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBond,
+				sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
 func handlerMsgUnBond(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgUnBond, simulate bool, txIndex int, msgIndex int) sdk.Result {
 	proxyContractHash := k.GetProxyContractHash(ctx)
-	sessionArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Name: "method",
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.UnbondMethodName}}}},
-		&consensus.Deploy_Arg{
-			Name: "amount",
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_OptionType{OptionType: &state.CLType_Option{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}}}}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_OptionValue{
-						OptionValue: &state.CLValueInstance_Option{
-							Value: &state.CLValueInstance_Value{
-								Value: &state.CLValueInstance_Value_U512{
-									U512: &state.CLValueInstance_U512{
-										Value: string(msg.Amount)}}}}}}}}}
-
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.UnbondMethodName).
+			Add("", types.SomeValue(types.U512Value(msg.Amount))))
 	if err != nil {
-		getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
 	msgExecute := NewMsgExecute(
@@ -295,40 +566,33 @@ func handlerMsgUnBond(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgUnBo
 		msg.FromAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
-
-	return getResult(result, log)
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnbond,
+				sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
 func handlerMsgDelegate(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgDelegate, simulate bool, txIndex int, msgIndex int) sdk.Result {
 	proxyContractHash := k.GetProxyContractHash(ctx)
-	sessionArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.DelegateMethodName}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_ListType{ListType: &state.CLType_List{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U8}}}}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_BytesValue{
-						BytesValue: msg.ValAddress}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_U512{
-						U512: &state.CLValueInstance_U512{
-							Value: msg.Amount}}}}}}
-
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.DelegateMethodName).
+			Add("", types.BytesValue(msg.ValAddress)).
+			Add("", types.U512Value(msg.Amount)))
 	if err != nil {
-		getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
 	msgExecute := NewMsgExecute(
@@ -336,43 +600,34 @@ func handlerMsgDelegate(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgDe
 		msg.FromAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
-
-	return getResult(result, log)
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDelegate,
+				sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
 func handlerMsgUndelgate(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgUndelegate, simulate bool, txIndex int, msgIndex int) sdk.Result {
 	proxyContractHash := k.GetProxyContractHash(ctx)
-	sessionArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.UndelegateMethodName}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_ListType{ListType: &state.CLType_List{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U8}}}}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_BytesValue{
-						BytesValue: msg.ValAddress}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_OptionType{OptionType: &state.CLType_Option{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}}}}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_OptionValue{
-						OptionValue: &state.CLValueInstance_Option{
-							Value: &state.CLValueInstance_Value{
-								Value: &state.CLValueInstance_Value_U512{
-									U512: &state.CLValueInstance_U512{
-										Value: msg.Amount}}}}}}}}}
-
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.UndelegateMethodName).
+			Add("", types.BytesValue(msg.ValAddress)).
+			Add("", types.SomeValue(types.U512Value(msg.Amount))))
 	if err != nil {
-		getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
 	msgExecute := NewMsgExecute(
@@ -380,49 +635,35 @@ func handlerMsgUndelgate(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgU
 		msg.FromAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
-
-	return getResult(result, log)
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUndelegate,
+				sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
 func handlerMsgRedelegate(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgRedelegate, simulate bool, txIndex int, msgIndex int) sdk.Result {
 	proxyContractHash := k.GetProxyContractHash(ctx)
-	sessionArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.RedelegateMethodName}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_ListType{ListType: &state.CLType_List{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U8}}}}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_BytesValue{
-						BytesValue: msg.SrcValAddress}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_ListType{ListType: &state.CLType_List{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U8}}}}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_BytesValue{
-						BytesValue: msg.DestValAddress}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_OptionValue{
-						OptionValue: &state.CLValueInstance_Option{
-							Value: &state.CLValueInstance_Value{
-								Value: &state.CLValueInstance_Value_U512{
-									U512: &state.CLValueInstance_U512{
-										Value: msg.Amount}}}}}}}}}
-
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.RedelegateMethodName).
+			Add("", types.BytesValue(msg.SrcValAddress)).
+			Add("", types.BytesValue(msg.DestValAddress)).
+			Add("", types.SomeValue(types.U512Value(msg.Amount))))
 	if err != nil {
-		getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
 	msgExecute := NewMsgExecute(
@@ -430,77 +671,40 @@ func handlerMsgRedelegate(ctx sdk.Context, k ExecutionLayerKeeper, msg types.Msg
 		msg.FromAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
-
-	return getResult(result, log)
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRedelegate,
+				sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeySrcValidator, msg.SrcValAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyDstValidator, msg.DestValAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
 func handlerMsgVote(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgVote, simulate bool, txIndex int, msgIndex int) sdk.Result {
 	proxyContractHash := k.GetProxyContractHash(ctx)
-	var sessionArgs []*consensus.Deploy_Arg
-
-	if strings.HasPrefix(msg.TargetContractAddress, sdk.Bech32PrefixContractURef) {
-		contractAddr, err := sdk.ContractUrefAddressFromBech32(msg.TargetContractAddress)
-		if err != nil {
-			getResult(false, err.Error())
-		}
-
-		sessionArgs = []*consensus.Deploy_Arg{
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_StrValue{
-							StrValue: types.VoteMethodName}}}},
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_KEY}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_Key{
-							Key: &state.Key{Value: &state.Key_Uref{Uref: &state.Key_URef{Uref: contractAddr.Bytes(), AccessRights: state.Key_URef_NONE}}}}}}},
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_U512{
-							U512: &state.CLValueInstance_U512{
-								Value: msg.Amount}}}}}}
-
-	} else if strings.HasPrefix(msg.TargetContractAddress, sdk.Bech32PrefixContractHash) {
-		contractAddr, err := sdk.ContractHashAddressFromBech32(msg.TargetContractAddress)
-		if err != nil {
-			getResult(false, err.Error())
-		}
-
-		sessionArgs = []*consensus.Deploy_Arg{
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_StrValue{
-							StrValue: types.VoteMethodName}}}},
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_KEY}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_Key{
-							Key: &state.Key{Value: &state.Key_Hash_{Hash: &state.Key_Hash{Hash: contractAddr.Bytes()}}}}}}},
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_U512{
-							U512: &state.CLValueInstance_U512{
-								Value: msg.Amount}}}}}}
-
-	}
-
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	contractKey, keyErr := types.ContractKey(msg.TargetContractAddress)
+	if keyErr != nil {
+		processDone(ctx, simulate)
+		return types.ErrInvalidDeployArgs(types.DefaultCodespace, keyErr.Error()).Result()
+	}
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.VoteMethodName).
+			Add("", types.KeyValue(contractKey)).
+			Add("", types.U512Value(msg.Amount)))
 	if err != nil {
-		getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
 	msgExecute := NewMsgExecute(
@@ -508,83 +712,39 @@ func handlerMsgVote(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgVote,
 		msg.FromAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
-
-	return getResult(result, log)
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVote,
+				sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyContract, msg.TargetContractAddress),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
 func handlerMsgUnvote(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgUnvote, simulate bool, txIndex int, msgIndex int) sdk.Result {
 	proxyContractHash := k.GetProxyContractHash(ctx)
-	var sessionArgs []*consensus.Deploy_Arg
-
-	if strings.HasPrefix(msg.TargetContractAddress, sdk.Bech32PrefixContractURef) {
-		contractAddr, err := sdk.ContractUrefAddressFromBech32(msg.TargetContractAddress)
-		if err != nil {
-			getResult(false, err.Error())
-		}
-
-		sessionArgs = []*consensus.Deploy_Arg{
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_StrValue{
-							StrValue: types.UnvoteMethodName}}}},
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_KEY}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_Key{
-							Key: &state.Key{Value: &state.Key_Uref{Uref: &state.Key_URef{Uref: contractAddr.Bytes(), AccessRights: state.Key_URef_NONE}}}}}}},
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_OptionType{OptionType: &state.CLType_Option{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}}}}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_OptionValue{
-							OptionValue: &state.CLValueInstance_Option{
-								Value: &state.CLValueInstance_Value{
-									Value: &state.CLValueInstance_Value_U512{
-										U512: &state.CLValueInstance_U512{
-											Value: msg.Amount}}}}}}}}}
-
-	} else if strings.HasPrefix(msg.TargetContractAddress, sdk.Bech32PrefixContractHash) {
-		contractAddr, err := sdk.ContractHashAddressFromBech32(msg.TargetContractAddress)
-		if err != nil {
-			getResult(false, err.Error())
-		}
-
-		sessionArgs = []*consensus.Deploy_Arg{
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_StrValue{
-							StrValue: types.UnvoteMethodName}}}},
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_KEY}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_Key{
-							Key: &state.Key{Value: &state.Key_Hash_{Hash: &state.Key_Hash{Hash: contractAddr.Bytes()}}}}}}},
-			&consensus.Deploy_Arg{
-				Value: &state.CLValueInstance{
-					ClType: &state.CLType{Variants: &state.CLType_OptionType{OptionType: &state.CLType_Option{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}}}}},
-					Value: &state.CLValueInstance_Value{
-						Value: &state.CLValueInstance_Value_OptionValue{
-							OptionValue: &state.CLValueInstance_Option{
-								Value: &state.CLValueInstance_Value{
-									Value: &state.CLValueInstance_Value_U512{
-										U512: &state.CLValueInstance_U512{
-											Value: msg.Amount}}}}}}}}}
-
-	}
-
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	contractKey, keyErr := types.ContractKey(msg.TargetContractAddress)
+	if keyErr != nil {
+		processDone(ctx, simulate)
+		return types.ErrInvalidDeployArgs(types.DefaultCodespace, keyErr.Error()).Result()
+	}
+	sessionArgs, err := encodeSessionArgs(
+		types.NewMethodArgs(types.UnvoteMethodName).
+			Add("", types.KeyValue(contractKey)).
+			Add("", types.SomeValue(types.U512Value(msg.Amount))))
 	if err != nil {
-		getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
 	msgExecute := NewMsgExecute(
@@ -592,37 +752,37 @@ func handlerMsgUnvote(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgUnvo
 		msg.FromAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
-
-	return getResult(result, log)
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+
+	if deployResult.Success {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnvote,
+				sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyContract, msg.TargetContractAddress),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
 func handlerMsgClaim(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgClaim, simulate bool, txIndex int, msgIndex int) sdk.Result {
 	proxyContractHash := k.GetProxyContractHash(ctx)
-	var methodName string
-	switch msg.RewardOrCommission {
-	case types.CommissionValue:
-		methodName = types.ClaimCommissionMethodName
-	case types.RewardValue:
-		methodName = types.ClaimRewardMethodName
-	default:
-		return getResult(false, "Must be reward or commission")
+	methodName, err := msg.MethodName()
+	if err != nil {
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
-	sessionArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: methodName}}}}}
-
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	sessionArgs, err := encodeSessionArgs(types.NewMethodArgs(methodName))
 	if err != nil {
-		return getResult(false, err.Error())
+		processDone(ctx, simulate)
+		return err.Result()
 	}
 
 	msgExecute := NewMsgExecute(
@@ -630,15 +790,128 @@ func handlerMsgClaim(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgClaim
 		msg.FromAddress,
 		util.HASH,
 		proxyContractHash,
-		hex.EncodeToString(sessionAbi),
+		sessionArgs,
 		msg.Fee,
 	)
-	result, log := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+	deployResult := execute(ctx, k, msgExecute, simulate, txIndex, msgIndex)
 
-	return getResult(result, log)
+	if deployResult.Success {
+		eventType := types.EventTypeClaimReward
+		if msg.RewardOrCommission == types.CommissionValue {
+			eventType = types.EventTypeClaimCommission
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+			),
+		)
+	}
+	return getDeployResult(deployResult)
 }
 
-func execute(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgExecute, simulate bool, txIndex int, msgIndex int) (bool, string) {
+// Handle MsgDeployContract
+// The session code is executed as wasm, and the contract it stores is registered under the name.
+func handlerMsgDeployContract(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgDeployContract, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	if _, found := k.GetRegisteredContract(ctx, msg.Name); found {
+		processDone(ctx, simulate)
+		return types.ErrContractNameExists(types.DefaultCodespace, msg.Name).Result()
+	}
+
+	deployArgs, _, err := types.DeployArgsFromJSON(msg.SessionArgs)
+	if err != nil {
+		processDone(ctx, simulate)
+		return types.ErrInvalidDeployArgs(types.DefaultCodespace, err.Error()).Result()
+	}
+	sessionArgs, sdkErr := encodeSessionArgs(deployArgs)
+	if sdkErr != nil {
+		processDone(ctx, simulate)
+		return sdkErr.Result()
+	}
+
+	msgExecute := NewMsgExecute(
+		"",
+		msg.DeployerAddress,
+		util.WASM,
+		msg.SessionCode,
+		sessionArgs,
+		msg.Fee,
+	)
+
+	deployResult, effects := executeWithEffects(ctx, k, msgExecute, simulate, txIndex, msgIndex)
+	if !deployResult.Success {
+		return getDeployResult(deployResult)
+	}
+
+	// CheckTx runs the session on the state of the last block, and rejects a session which doesn't store
+	// exactly one contract. In the block the session is executed once, and its effects are committed
+	// with the fee charged, so the deploy succeeds and only the name is not registered.
+	contracts := storedContracts(effects)
+	if len(contracts) != 1 {
+		if simulate {
+			res := types.ErrContractNotStored(types.DefaultCodespace, len(contracts)).Result()
+			res.GasUsed = deployResult.GasCost
+			return res
+		}
+		res := getDeployResult(deployResult)
+		res.Log = types.ErrContractNotStored(types.DefaultCodespace, len(contracts)).Error()
+		return res
+	}
+
+	codeHash := contractString(util.WASM, msg.SessionCode)
+	if !simulate {
+		k.SetRegisteredContract(ctx, types.NewRegisteredContract(
+			msg.Name, msg.DeployerAddress, codeHash, contracts[0], ctx.BlockHeight(), msg.Metadata))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeployContract,
+			sdk.NewAttribute(types.AttributeKeySender, msg.DeployerAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash),
+			sdk.NewAttribute(types.AttributeKeyContract, contracts[0]),
+			sdk.NewAttribute(types.AttributeKeyFee, msg.Fee),
+		),
+	)
+	return getDeployResult(deployResult)
+}
+
+// storedContracts returns the bech32 addresses of the contracts which the effects of a deploy write
+func storedContracts(effects []*transforms.TransformEntry) []string {
+	contracts := []string{}
+	for _, effect := range effects {
+		if effect.GetTransform().GetWrite().GetValue().GetContract() == nil {
+			continue
+		}
+		switch key := effect.GetKey(); key.GetValue().(type) {
+		case *state.Key_Hash_:
+			contracts = append(contracts, sdk.ContractHashAddress(key.GetHash().GetHash()).String())
+		case *state.Key_Uref:
+			contracts = append(contracts, sdk.ContractUrefAddress(key.GetUref().GetUref()).String())
+		}
+	}
+	return contracts
+}
+
+// PayFromPurse transfers the amount from the purse of the payer to the recipient, as a deploy with the fee.
+// Other modules charge their prices by it, e.g. nickname registrations.
+func (k ExecutionLayerKeeper) PayFromPurse(
+	ctx sdk.Context, contractAddress string, payer, recipient sdk.AccAddress, amount, fee string,
+	simulate bool, txIndex int, msgIndex int,
+) sdk.Result {
+	msg := types.NewMsgTransfer(contractAddress, payer, recipient, amount, fee)
+	return handlerMsgTransfer(ctx, k, msg, simulate, txIndex, msgIndex)
+}
+
+func execute(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgExecute, simulate bool, txIndex int, msgIndex int) types.DeployResult {
+	deployResult, _ := executeWithEffects(ctx, k, msg, simulate, txIndex, msgIndex)
+	return deployResult
+}
+
+// executeWithEffects executes the message as a deploy, and returns the effects of the deploy as well
+func executeWithEffects(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgExecute, simulate bool, txIndex int, msgIndex int) (types.DeployResult, []*transforms.TransformEntry) {
 	proxyContractHash := k.GetProxyContractHash(ctx)
 	// Parameter preparation
 	var stateHash []byte
@@ -650,34 +923,42 @@ func execute(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgExecute, simu
 		stateHash = ctx.CandidateBlock().State
 		protocolVersion = *ctx.CandidateBlock().ProtocolVersion
 	}
-	log := ""
-
-	paymentArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.PaymentMethodName}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_U512{
-						U512: &state.CLValueInstance_U512{
-							Value: string(msg.Fee)}}}}}}
-
-	paymentAbi, err := util.AbiDeployArgsTobytes(paymentArgs)
+	index := 0
+
+	msgHash := util.Blake2b256(msg.GetSignBytes())
+
+	// the associated keys authorize the deploy together with the sender,
+	// and a fee payer pays the fee on behalf of the sender, authorizing the deploy with them
+	paymentArgs := types.NewMethodArgs(types.PaymentMethodName)
+	authorizationKeys := [][]byte{msg.ExecAddress}
+	for _, key := range msg.AuthorizationKeys {
+		if !key.Equals(msg.ExecAddress) {
+			authorizationKeys = append(authorizationKeys, key)
+		}
+	}
+	if !msg.FeePayer.Empty() {
+		paymentArgs = types.NewMethodArgs(types.DelegatedPaymentMethodName).
+			Add("", types.BytesValue(msg.FeePayer))
+		authorizationKeys = append(authorizationKeys, msg.FeePayer)
+	}
+	paymentAbi, err := paymentArgs.
+		Add("", types.U512Value(msg.Fee)).
+		Encode()
 	if err != nil {
-		return false, err.Error()
+		processDone(ctx, simulate)
+		return types.NewDeployResult(hex.EncodeToString(msgHash), types.DeployErrorInvalidArgs, err.Error(), 0, 0), nil
 	}
 
 	sessionAbi, err := hex.DecodeString(msg.SessionArgs)
 	if err != nil {
-		return false, err.Error()
+		processDone(ctx, simulate)
+		return types.NewDeployResult(hex.EncodeToString(msgHash), types.DeployErrorInvalidArgs, err.Error(), 0, 0), nil
 	}
 
-	msgHash := util.Blake2b256(msg.GetSignBytes())
+	if errorKind, errorMessage := checkDeployParams(ctx, k, msg, sessionAbi); errorKind != types.DeployErrorNone {
+		processDone(ctx, simulate)
+		return types.NewDeployResult(hex.EncodeToString(msgHash), errorKind, errorMessage, 0, 0), nil
+	}
 
 	// Execute
 	deploys := []*ipc.DeployItem{}
@@ -685,12 +966,13 @@ func execute(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgExecute, simu
 		Address:           msg.ExecAddress,
 		Session:           util.MakeDeployPayload(msg.SessionType, msg.SessionCode, sessionAbi),
 		Payment:           util.MakeDeployPayload(util.HASH, proxyContractHash, paymentAbi),
-		AuthorizationKeys: [][]byte{msg.ExecAddress},
+		AuthorizationKeys: authorizationKeys,
 		DeployHash:        msgHash,
-		GasPrice:          types.BASIC_GAS,
+		GasPrice:          k.GasPrice(ctx),
 	}
 	deploys = append(deploys, deploy)
 
+	var resExecute *ipc.ExecuteResponse
 	if simulate {
 		reqExecute := &ipc.ExecuteRequest{
 			ParentStateHash: stateHash,
@@ -698,52 +980,102 @@ func execute(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgExecute, simu
 			Deploys:         deploys,
 			ProtocolVersion: &protocolVersion,
 		}
-		resExecute, err := k.client.Execute(ctx.Context(), reqExecute)
+		resExecute, err = k.client.Execute(ctx.Context(), reqExecute)
 		if err != nil {
-			return false, err.Error()
-		}
-
-		effects := []*transforms.TransformEntry{}
-		switch resExecute.GetResult().(type) {
-		case *ipc.ExecuteResponse_Success:
-			res := resExecute.GetSuccess().GetDeployResults()
-			switch res[0].GetExecutionResult().GetError().GetValue().(type) {
-			case *ipc.DeployError_GasError:
-				err = types.ErrGRpcExecuteDeployGasError(types.DefaultCodespace)
-			case *ipc.DeployError_ExecError:
-				err = types.ErrGRpcExecuteDeployExecError(types.DefaultCodespace, res[0].GetExecutionResult().GetError().GetExecError().GetMessage())
-			}
-
-			effects = append(effects, res[0].GetExecutionResult().GetEffects().GetTransformMap()...)
-			if err != nil {
-				log = fmt.Sprintf(log, err.Error())
-			}
-
-		case *ipc.ExecuteResponse_MissingParent:
-			err = types.ErrGRpcExecuteMissingParent(types.DefaultCodespace, util.EncodeToHexString(resExecute.GetMissingParent().GetHash()))
-			log = err.Error()
-		default:
-			err = fmt.Errorf("Unknown result : %s", resExecute.String())
-			log = err.Error()
+			return types.NewDeployResult(hex.EncodeToString(msgHash), types.DeployErrorEngine, err.Error(), 0, 0), nil
 		}
 	} else {
-		ch := make(chan string, 1)
+		ch := make(chan sdk.DeployResult, 1)
 
 		candidateBlock := ctx.CandidateBlock()
 		itemDeploy := &sdk.ItemDeploy{
-			TxIndex:    txIndex,
-			MsgIndex:   msgIndex,
-			Deploy:     deploy,
-			LogChannel: ch,
+			TxIndex:       txIndex,
+			MsgIndex:      msgIndex,
+			Deploy:        deploy,
+			ResultChannel: ch,
 		}
 		candidateBlock.DeployPQueue.Put(itemDeploy)
 
 		candidateBlock.WaitGroup.Done()
 		ctx = ctx.WithCandidateBlock(candidateBlock)
-		log = <-ch
+		res := <-ch
+		resExecute = res.Response
+		index = res.Index
+	}
+
+	deployResult := newDeployResult(hex.EncodeToString(msgHash), resExecute, index)
+	if deployResult.Success {
+		event := sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ExecAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDeployHash, deployResult.DeployHash),
+		)
+		if !msg.FeePayer.Empty() {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyFeePayer, msg.FeePayer.String()))
+		}
+		ctx.EventManager().EmitEvent(event)
+	}
+
+	deployResults := resExecute.GetSuccess().GetDeployResults()
+	if index < len(deployResults) {
+		return deployResult, deployResults[index].GetExecutionResult().GetEffects().GetTransformMap()
+	}
+	return deployResult, nil
+}
+
+// checkDeployParams checks the fee, the wasm code and the session args of a deploy against the params
+func checkDeployParams(ctx sdk.Context, k ExecutionLayerKeeper, msg types.MsgExecute, sessionAbi []byte) (errorKind string, errorMessage string) {
+	fee, ok := sdk.NewIntFromString(msg.Fee)
+	if !ok {
+		return types.DeployErrorInvalidArgs, fmt.Sprintf("invalid fee: %s", msg.Fee)
+	}
+	if minFee := k.MinFee(ctx); fee.LT(minFee) {
+		return types.DeployErrorFeeTooLow, fmt.Sprintf("%s < %s", fee, minFee)
+	}
+	if msg.SessionType == util.WASM {
+		if maxDeploySize := k.MaxDeploySize(ctx); uint64(len(msg.SessionCode)) > uint64(maxDeploySize) {
+			return types.DeployErrorDeployTooLarge, fmt.Sprintf("%d > %d bytes", len(msg.SessionCode), maxDeploySize)
+		}
+	}
+	if maxLength := k.MaxSessionArgsLength(ctx); uint64(len(sessionAbi)) > uint64(maxLength) {
+		return types.DeployErrorSessionArgsTooLong, fmt.Sprintf("%d > %d bytes", len(sessionAbi), maxLength)
 	}
+	return types.DeployErrorNone, ""
+}
+
+// newDeployResult summarizes the result of the deploy at index in the response of the execution engine
+func newDeployResult(deployHash string, resExecute *ipc.ExecuteResponse, index int) types.DeployResult {
+	switch resExecute.GetResult().(type) {
+	case *ipc.ExecuteResponse_Success:
+		deployResults := resExecute.GetSuccess().GetDeployResults()
+		if index >= len(deployResults) {
+			return types.NewDeployResult(deployHash, types.DeployErrorUnknown, fmt.Sprintf("no result of the deploy %s", deployHash), 0, 0)
+		}
+
+		res := deployResults[index]
+		if res.GetPreconditionFailure() != nil {
+			return types.NewDeployResult(deployHash, types.DeployErrorPreconditionFailure, res.GetPreconditionFailure().GetMessage(), 0, 0)
+		}
+
+		errorKind, errorMessage := types.DeployErrorNone, ""
+		switch res.GetExecutionResult().GetError().GetValue().(type) {
+		case *ipc.DeployError_GasError:
+			errorKind = types.DeployErrorGas
+		case *ipc.DeployError_ExecError:
+			errorKind = types.DeployErrorExec
+			errorMessage = res.GetExecutionResult().GetError().GetExecError().GetMessage()
+		}
+
+		gasCost, _ := strconv.ParseUint(res.GetExecutionResult().GetCost().GetValue(), 10, 64)
+		effectsCount := len(res.GetExecutionResult().GetEffects().GetTransformMap())
+		return types.NewDeployResult(deployHash, errorKind, errorMessage, gasCost, effectsCount)
 
-	return log == "", log
+	case *ipc.ExecuteResponse_MissingParent:
+		return types.NewDeployResult(deployHash, types.DeployErrorMissingParent, util.EncodeToHexString(resExecute.GetMissingParent().GetHash()), 0, 0)
+	default:
+		return types.NewDeployResult(deployHash, types.DeployErrorUnknown, resExecute.String(), 0, 0)
+	}
 }
 
 func getResult(ok bool, log string) sdk.Result {
@@ -758,22 +1090,105 @@ func getResult(ok bool, log string) sdk.Result {
 	return res
 }
 
-func getPayAmountSessionArgsStr(amount string) ([]byte, error) {
-	sessionArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.PaymentMethodName}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_U512{
-						U512: &state.CLValueInstance_U512{
-							Value: amount}}}}}}
-	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
-
-	return sessionAbi, err
+// contractString returns the bech32 address of a stored contract, or the hash of wasm code
+func contractString(contractType util.ContractType, code []byte) string {
+	switch contractType {
+	case util.HASH:
+		return sdk.ContractHashAddress(code).String()
+	case util.UREF:
+		return sdk.ContractUrefAddress(code).String()
+	default:
+		return hex.EncodeToString(util.Blake2b256(code))
+	}
+}
+
+// getDeployResult returns the result of a message executed as a deploy.
+// A failed deploy carries the codespace and code of its error,
+// the deploy result is the data and its gas cost is reported as used gas.
+func getDeployResult(deployResult types.DeployResult) sdk.Result {
+	res := getResult(true, "")
+	if err := deployResult.Err(); err != nil {
+		res = err.Result()
+	}
+	res.Data = types.ModuleCdc.MustMarshalBinaryLengthPrefixed(deployResult)
+	res.GasUsed = deployResult.GasCost
+	return res
+}
+
+func getPayAmountSessionArgsStr(amount string) (string, sdk.Error) {
+	return encodeSessionArgs(types.NewMethodArgs(types.PaymentMethodName).Add("", types.U512Value(amount)))
+}
+
+// executePayment executes a deploy which only pays the basic amount through the proxy contract,
+// for the messages which are handled out of the execution engine
+func executePayment(ctx sdk.Context, k ExecutionLayerKeeper, contractAddress string, fromAddress sdk.AccAddress, fee string, simulate bool, txIndex int, msgIndex int) (types.DeployResult, sdk.Error) {
+	sessionArgs, err := getPayAmountSessionArgsStr(types.BASIC_PAY_AMOUNT)
+	if err != nil {
+		processDone(ctx, simulate)
+		return types.DeployResult{}, err
+	}
+
+	msgExecute := NewMsgExecute(
+		contractAddress,
+		fromAddress,
+		util.HASH,
+		k.GetProxyContractHash(ctx),
+		sessionArgs,
+		fee,
+	)
+	return execute(ctx, k, msgExecute, simulate, txIndex, msgIndex), nil
+}
+
+// encodeSessionArgs returns the hex string of the encoded session args
+func encodeSessionArgs(args types.DeployArgs) (string, sdk.Error) {
+	abi, err := args.Encode()
+	if err != nil {
+		return "", types.ErrInvalidDeployArgs(types.DefaultCodespace, err.Error())
+	}
+	return hex.EncodeToString(abi), nil
+}
+
+// processDone releases the candidate block of a message which fails before its deploy is queued
+func processDone(ctx sdk.Context, simulate bool) {
+	if !simulate {
+		candidateBlock := ctx.CandidateBlock()
+		candidateBlock.WaitGroup.Done()
+	}
+}
+
+// NewEngineUpgradeProposalHandler returns a handler for the engine upgrade proposals passed by governance
+func NewEngineUpgradeProposalHandler(k ExecutionLayerKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.EngineUpgradeProposal:
+			return handleEngineUpgradeProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized execution layer proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// handleEngineUpgradeProposal schedules the engine upgrade, replacing the one scheduled before.
+// The upgrade is applied by BeginBlocker at its height.
+func handleEngineUpgradeProposal(ctx sdk.Context, k ExecutionLayerKeeper, p types.EngineUpgradeProposal) sdk.Error {
+	if p.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidUpgradeHeight(types.DefaultCodespace, p.Height)
+	}
+
+	protocolVersion, err := types.ToProtocolVersion(p.ProtocolVersion)
+	if err != nil {
+		return types.ErrProtocolVersionParse(types.DefaultCodespace, p.ProtocolVersion)
+	}
+	currentProtocolVersion := k.GetProtocolVersion(ctx)
+	if !types.ProtocolVersionLess(currentProtocolVersion, *protocolVersion) {
+		return types.ErrInvalidUpgradeProtocolVersion(
+			types.DefaultCodespace, p.ProtocolVersion, types.ProtocolVersionString(currentProtocolVersion))
+	}
+
+	k.SetScheduledEngineUpgrade(ctx, p)
+
+	k.Logger(ctx).Info(fmt.Sprintf("scheduled engine upgrade to protocol version %s at height %d", p.ProtocolVersion, p.Height))
+	return nil
 }
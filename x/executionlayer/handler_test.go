@@ -0,0 +1,480 @@
+package executionlayer
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/grpc"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+	abci "github.com/hdac-io/tendermint/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func getEvent(events sdk.Events, eventType string) map[string]string {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		attributes := map[string]string{}
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+		return attributes
+	}
+	return nil
+}
+
+func TestHandlerTransferEvents(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	msg := types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000", types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+
+	message := getEvent(res.Events, sdk.EventTypeMessage)
+	require.NotNil(t, message)
+	require.Equal(t, types.AttributeValueCategory, message[sdk.AttributeKeyModule])
+	require.Equal(t, GenesisAccountAddress.String(), message[sdk.AttributeKeySender])
+	require.Len(t, message[types.AttributeKeyDeployHash], 64)
+
+	transfer := getEvent(res.Events, types.EventTypeTransfer)
+	require.NotNil(t, transfer)
+	require.Equal(t, GenesisAccountAddress.String(), transfer[types.AttributeKeySender])
+	require.Equal(t, RecipientAccountAddress.String(), transfer[types.AttributeKeyRecipient])
+	require.Equal(t, "1000", transfer[types.AttributeKeyAmount])
+	require.Equal(t, types.BASIC_FEE, transfer[types.AttributeKeyFee])
+
+	results, err := types.DecodeDeployResults(res.Data)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.True(t, results[0].Success)
+	require.Equal(t, message[types.AttributeKeyDeployHash], results[0].DeployHash)
+	require.NotZero(t, results[0].GasCost)
+	require.NotZero(t, results[0].EffectsCount)
+	require.Equal(t, results[0].GasCost, res.GasUsed)
+}
+
+func TestHandlerMultiTransfer(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	outputs := []types.TransferOutput{
+		types.NewTransferOutput(RecipientAccountAddress, "1000"),
+		types.NewTransferOutput(GenesisAccountAddress, "2000"),
+	}
+	msg := types.NewMsgMultiTransfer(ContractAddress, GenesisAccountAddress, outputs, types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+
+	// a transfer event for each recipient
+	transfers := []map[string]string{}
+	for _, event := range res.Events {
+		if event.Type == types.EventTypeTransfer {
+			transfers = append(transfers, getEvent(sdk.Events{event}, types.EventTypeTransfer))
+		}
+	}
+	require.Len(t, transfers, 2)
+	for i, transfer := range transfers {
+		require.Equal(t, GenesisAccountAddress.String(), transfer[types.AttributeKeySender])
+		require.Equal(t, outputs[i].ToAddress.String(), transfer[types.AttributeKeyRecipient])
+		require.Equal(t, outputs[i].Amount, transfer[types.AttributeKeyAmount])
+		require.Equal(t, types.BASIC_FEE, transfer[types.AttributeKeyFee])
+	}
+
+	// the transfers fail all together, even if the balance covers some of them
+	outputs[1] = types.NewTransferOutput(RecipientAccountAddress, "1000000000000000000")
+	msg = types.NewMsgMultiTransfer(ContractAddress, GenesisAccountAddress, outputs, types.BASIC_FEE)
+	res = handler(input.ctx, msg, true, 0, 0)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeGRpcExecuteDeployExecError, res.Code)
+	require.Empty(t, res.Events)
+}
+
+func TestHandlerFeePayer(t *testing.T) {
+	input := setupTestInput()
+	accounts := append(input.elk.GetGenesisAccounts(input.ctx), types.Account{
+		Address:             RecipientAccountAddress,
+		InitialBalance:      "1000",
+		InitialBondedAmount: "0",
+	})
+	input.elk.SetGenesisAccounts(input.ctx, accounts)
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	// the balance of the sender doesn't cover the fee
+	msg := types.NewMsgTransfer(ContractAddress, RecipientAccountAddress, GenesisAccountAddress, "1000", types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeGRpcExecuteDeployPreconditionFailure, res.Code)
+
+	// the fee payer co-signs, and pays the fee
+	msg.FeePayer = GenesisAccountAddress
+	require.Equal(t, []sdk.AccAddress{RecipientAccountAddress, GenesisAccountAddress}, msg.GetSigners())
+	res = handler(input.ctx, msg, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+
+	message := getEvent(res.Events, sdk.EventTypeMessage)
+	require.NotNil(t, message)
+	require.Equal(t, RecipientAccountAddress.String(), message[sdk.AttributeKeySender])
+	require.Equal(t, GenesisAccountAddress.String(), message[types.AttributeKeyFeePayer])
+}
+
+func TestHandlerPayFromPurse(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+
+	res := input.elk.PayFromPurse(input.ctx, ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000", types.BASIC_FEE, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.NotZero(t, res.GasUsed)
+
+	// more than the balance
+	res = input.elk.PayFromPurse(input.ctx, ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000000000000000000000000000000000000", types.BASIC_FEE, true, 0, 0)
+	require.False(t, res.IsOK())
+
+	// the amount moves to the recipient in the block
+	ctx := input.ctx.WithBlockHeight(1)
+	BeginBlocker(ctx, abci.RequestBeginBlock{Header: abci.Header{Height: 1, NumTxs: 1}}, input.elk)
+	results := make(chan sdk.Result, 1)
+	go func() {
+		results <- input.elk.PayFromPurse(ctx, ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000", types.BASIC_FEE, false, 0, 0)
+	}()
+	EndBlocker(ctx, abci.RequestEndBlock{Height: 1}, input.elk)
+	res = <-results
+	require.True(t, res.IsOK(), res.Log)
+
+	protocolVersion := input.elk.GetProtocolVersion(ctx)
+	balance, errStr := grpc.QueryBalance(input.elk.client, input.elk.GetUnitHashMap(ctx, 1).EEState, RecipientAccountAddress, &protocolVersion)
+	require.Empty(t, errStr)
+	require.Equal(t, "1000", balance)
+}
+
+func TestHandlerAssociatedKeys(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	msg := types.NewMsgAddAssociatedKey(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, 1, types.BASIC_FEE, nil)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+
+	added := getEvent(res.Events, types.EventTypeAddAssociatedKey)
+	require.NotNil(t, added)
+	require.Equal(t, GenesisAccountAddress.String(), added[types.AttributeKeySender])
+	require.Equal(t, RecipientAccountAddress.String(), added[types.AttributeKeyAssociatedKey])
+	require.Equal(t, "1", added[types.AttributeKeyWeight])
+
+	// the key management threshold can't exceed the total weight of the keys
+	thresholds := types.NewMsgSetActionThresholds(ContractAddress, GenesisAccountAddress, 2, 2, types.BASIC_FEE, nil)
+	res = handler(input.ctx, thresholds, true, 0, 0)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeGRpcExecuteDeployExecError, res.Code)
+
+	// the account signs the deploy together with the authorization keys
+	transfer := types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000", types.BASIC_FEE)
+	transfer.AuthorizationKeys = []sdk.AccAddress{RecipientAccountAddress}
+	require.Equal(t, []sdk.AccAddress{GenesisAccountAddress, RecipientAccountAddress}, transfer.GetSigners())
+	res = handler(input.ctx, transfer, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+}
+
+func TestHandlerFailureEmitsNoEvents(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	// the amount exceeds the balance
+	msg := types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000000000000000000", types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.DefaultCodespace, res.Codespace)
+	require.Equal(t, types.CodeGRpcExecuteDeployExecError, res.Code)
+	require.Empty(t, res.Events)
+
+	// the deploy is charged even though it failed
+	results, err := types.DecodeDeployResults(res.Data)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.False(t, results[0].Success)
+	require.Equal(t, types.DeployErrorExec, results[0].ErrorKind)
+	require.Equal(t, res.Code, results[0].Code)
+	require.NotZero(t, res.GasUsed)
+}
+
+func TestHandlerPreconditionFailure(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	// the sender has no account
+	sender := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	msg := types.NewMsgTransfer(ContractAddress, sender, GenesisAccountAddress, "1000", types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.DefaultCodespace, res.Codespace)
+	require.Equal(t, types.CodeGRpcExecuteDeployPreconditionFailure, res.Code)
+
+	results, err := types.DecodeDeployResults(res.Data)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, types.DeployErrorPreconditionFailure, results[0].ErrorKind)
+	require.Zero(t, res.GasUsed)
+}
+
+func TestHandlerInvalidDeployArgs(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	msg := types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "-1", types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.DefaultCodespace, res.Codespace)
+	require.Equal(t, types.CodeInvalidInput, res.Code)
+	require.Empty(t, res.Events)
+
+}
+
+func TestHandlerDeployContract(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	msg := types.NewMsgDeployContract(GenesisAccountAddress, "counter", []byte{0x00, 0x61, 0x73, 0x6d}, "", "counter contract", types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.NotZero(t, res.GasUsed)
+
+	deploy := getEvent(res.Events, types.EventTypeDeployContract)
+	require.NotNil(t, deploy)
+	require.Equal(t, GenesisAccountAddress.String(), deploy[types.AttributeKeySender])
+	require.Equal(t, "counter", deploy[types.AttributeKeyName])
+	require.Len(t, deploy[types.AttributeKeyCodeHash], 64)
+	_, err := sdk.ContractHashAddressFromBech32(deploy[types.AttributeKeyContract])
+	require.NoError(t, err)
+
+	// the registry is not written on simulation
+	_, found := input.elk.GetRegisteredContract(input.ctx, "counter")
+	require.False(t, found)
+
+	input.elk.SetRegisteredContract(input.ctx, types.NewRegisteredContract("counter", RecipientAccountAddress, "", deploy[types.AttributeKeyContract], 1, ""))
+	res = handler(input.ctx, msg, true, 0, 0)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.DefaultCodespace, res.Codespace)
+	require.Equal(t, types.CodeContractNameExists, res.Code)
+
+	msg = types.NewMsgDeployContract(GenesisAccountAddress, "counter2", []byte{0x00, 0x61, 0x73, 0x6d}, "[invalid", "", types.BASIC_FEE)
+	res = handler(input.ctx, msg, true, 0, 0)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeInvalidInput, res.Code)
+}
+
+func TestEngineUpgradeProposalHandler(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	ctx := input.ctx.WithBlockHeight(10)
+	handler := NewEngineUpgradeProposalHandler(input.elk)
+
+	err := handler(ctx, types.NewEngineUpgradeProposal("title", "description", 10, "2.0.0", nil, nil))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeInvalidUpgradeHeight, err.Code())
+
+	current := types.ProtocolVersionString(input.elk.GetProtocolVersion(ctx))
+	err = handler(ctx, types.NewEngineUpgradeProposal("title", "description", 11, current, nil, nil))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeInvalidUpgradeProtocolVersion, err.Code())
+
+	_, found := input.elk.GetScheduledEngineUpgrade(ctx)
+	require.False(t, found)
+
+	proposal := types.NewEngineUpgradeProposal("title", "description", 11, "2.0.0", nil, []byte{0, 1})
+	require.Nil(t, handler(ctx, proposal))
+	scheduled, found := input.elk.GetScheduledEngineUpgrade(ctx)
+	require.True(t, found)
+	require.Equal(t, proposal, scheduled)
+}
+
+func TestHandlerJailAndUnjail(t *testing.T) {
+	input := setupTestInput()
+	// the validator pays the basic amount for the messages
+	accounts := input.elk.GetGenesisAccounts(input.ctx)
+	accounts[0].InitialBalance = "5000000000000000000000"
+	input.elk.SetGenesisAccounts(input.ctx, accounts)
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	consPubKey, _ := sdk.GetConsPubKeyBech32("fridayvalconspub16jrl8jvqq98x7jjxfcm8252pwd4nv6fetpzk6nzx2ddyc3fn0p2rz4mwf44nqjtfga5k5at4xad82sjhx9r9zdfcwuc5uvt90934jjr4d4xk242909rxks28v9erv3jvwfcx2wp4fe8h54fsddu9zar5v3tyknrs8pykk2mw2p29j4n6w455c7j2d3x4ykft9akx6s24gsu8ys2nvayrykqst965z")
+	validator := types.NewValidator(GenesisAccountAddress, consPubKey, types.Description{}, "")
+	input.elk.SetValidator(input.ctx, GenesisAccountAddress, validator)
+	input.elk.SetValidatorByConsAddr(input.ctx, validator)
+
+	blockTime := time.Unix(1583712000, 0).UTC()
+	ctx := input.ctx.WithBlockTime(blockTime)
+
+	// no validator, nothing to jail
+	res := handler(ctx, types.NewMsgJail(ContractAddress, RecipientAccountAddress, types.BASIC_FEE), true, 0, 0)
+	require.Equal(t, types.CodeInvalidValidator, res.Code)
+
+	// not jailed, nothing to unjail
+	res = handler(ctx, types.NewMsgUnjail(ContractAddress, GenesisAccountAddress, types.BASIC_FEE), true, 0, 0)
+	require.Equal(t, types.CodeValidatorNotJailed, res.Code)
+
+	res = handler(ctx, types.NewMsgJail(ContractAddress, GenesisAccountAddress, types.BASIC_FEE), true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.NotNil(t, getEvent(res.Events, types.EventTypeJail))
+	validator, _ = input.elk.GetValidator(ctx, GenesisAccountAddress)
+	require.True(t, validator.Jailed)
+
+	res = handler(ctx, types.NewMsgJail(ContractAddress, GenesisAccountAddress, types.BASIC_FEE), true, 0, 0)
+	require.Equal(t, types.CodeValidatorJailed, res.Code)
+
+	// jailed for downtime, cannot be unjailed until the jail period ends
+	input.elk.SetValidatorSigningInfo(ctx, validator.ConsAddress(),
+		types.NewValidatorSigningInfo(validator.ConsAddress(), 1, 5, blockTime.Add(time.Hour), false, 3))
+	res = handler(ctx, types.NewMsgUnjail(ContractAddress, GenesisAccountAddress, types.BASIC_FEE), true, 0, 0)
+	require.Equal(t, types.CodeValidatorJailedFor, res.Code)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour)).WithBlockHeight(20)
+	input.elk.SetUnitHashMap(ctx, input.elk.GetUnitHashMap(input.ctx, 0))
+	res = handler(ctx, types.NewMsgUnjail(ContractAddress, GenesisAccountAddress, types.BASIC_FEE), true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+	require.NotNil(t, getEvent(res.Events, types.EventTypeUnjail))
+	validator, _ = input.elk.GetValidator(ctx, GenesisAccountAddress)
+	require.False(t, validator.Jailed)
+
+	// the signed blocks window restarts
+	info, _ := input.elk.GetValidatorSigningInfo(ctx, validator.ConsAddress())
+	require.Equal(t, int64(20), info.StartHeight)
+	require.Zero(t, info.IndexOffset)
+	require.Zero(t, info.MissedBlocksCounter)
+
+	// a tombstoned validator cannot be unjailed
+	validator.Jailed = true
+	input.elk.SetValidator(ctx, GenesisAccountAddress, validator)
+	input.elk.SetValidatorSigningInfo(ctx, validator.ConsAddress(),
+		types.NewValidatorSigningInfo(validator.ConsAddress(), 20, 0, DoubleSignJailEndTime, true, 0))
+	res = handler(ctx, types.NewMsgUnjail(ContractAddress, GenesisAccountAddress, types.BASIC_FEE), true, 0, 0)
+	require.Equal(t, types.CodeValidatorTombstoned, res.Code)
+}
+
+// waitDone fails the test if the handler didn't release the candidate block
+func waitDone(t *testing.T, candidateBlock *sdk.CandidateBlock) {
+	done := make(chan struct{})
+	go func() {
+		candidateBlock.WaitGroup.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("candidate block is not released")
+	}
+}
+
+func TestHandlerCreateValidatorReleasesBlock(t *testing.T) {
+	input := setupTestInput()
+	handler := NewHandler(input.elk)
+	consPubKey, _ := sdk.GetConsPubKeyBech32("fridayvalconspub16jrl8jvqq98x7jjxfcm8252pwd4nv6fetpzk6nzx2ddyc3fn0p2rz4mwf44nqjtfga5k5at4xad82sjhx9r9zdfcwuc5uvt90934jjr4d4xk242909rxks28v9erv3jvwfcx2wp4fe8h54fsddu9zar5v3tyknrs8pykk2mw2p29j4n6w455c7j2d3x4ykft9akx6s24gsu8ys2nvayrykqst965z")
+	msg := types.NewMsgCreateValidator(ContractAddress, GenesisAccountAddress, consPubKey, types.Description{}, sdk.ZeroDec(), types.BASIC_FEE)
+
+	// no proxy contract to deploy
+	candidateBlock := &sdk.CandidateBlock{}
+	candidateBlock.WaitGroup.Add(1)
+	res := handler(input.ctx.WithCandidateBlock(candidateBlock), msg, false, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+	waitDone(t, candidateBlock)
+
+	// rejected before the deploy
+	candidateBlock = &sdk.CandidateBlock{}
+	candidateBlock.WaitGroup.Add(1)
+	res = handler(input.ctx.WithCandidateBlock(candidateBlock), msg, false, 0, 0)
+	require.Equal(t, types.CodeInvalidValidator, res.Code)
+	waitDone(t, candidateBlock)
+}
+
+func TestHandlerCommissionRate(t *testing.T) {
+	input := setupTestInput()
+	accounts := input.elk.GetGenesisAccounts(input.ctx)
+	accounts[0].InitialBalance = types.SYSTEM_ACCOUNT_BALANCE
+	input.elk.SetGenesisAccounts(input.ctx, accounts)
+	genesis(input)
+	handler := NewHandler(input.elk)
+	params := types.DefaultParams()
+	params.MinCommissionRate, params.MaxCommissionRate = sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 1)
+	input.elk.SetParams(input.ctx, params)
+	consPubKey, _ := sdk.GetConsPubKeyBech32("fridayvalconspub16jrl8jvqq98x7jjxfcm8252pwd4nv6fetpzk6nzx2ddyc3fn0p2rz4mwf44nqjtfga5k5at4xad82sjhx9r9zdfcwuc5uvt90934jjr4d4xk242909rxks28v9erv3jvwfcx2wp4fe8h54fsddu9zar5v3tyknrs8pykk2mw2p29j4n6w455c7j2d3x4ykft9akx6s24gsu8ys2nvayrykqst965z")
+	description := types.NewDescription("moniker", "", "", "")
+
+	msg := types.NewMsgCreateValidator(ContractAddress, GenesisAccountAddress, consPubKey, description, sdk.NewDecWithPrec(3, 1), types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.Equal(t, types.CodeInvalidCommissionRate, res.Code)
+
+	msg.CommissionRate = sdk.NewDecWithPrec(1, 1)
+	res = handler(input.ctx, msg, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+	validator, _ := input.elk.GetValidator(input.ctx, GenesisAccountAddress)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), validator.CommissionRate)
+
+	rate := sdk.NewDecWithPrec(1, 3)
+	edit := types.NewMsgEditValidator(ContractAddress, GenesisAccountAddress, description, &rate, types.BASIC_FEE)
+	res = handler(input.ctx, edit, true, 0, 0)
+	require.Equal(t, types.CodeInvalidCommissionRate, res.Code)
+
+	rate = sdk.NewDecWithPrec(2, 1)
+	res = handler(input.ctx, edit, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+	validator, _ = input.elk.GetValidator(input.ctx, GenesisAccountAddress)
+	require.Equal(t, rate, validator.CommissionRate)
+}
+
+func TestHandlerDeployParams(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	params := types.DefaultParams()
+	params.MinFee = sdk.NewInt(20000000000000000)
+	input.elk.SetParams(input.ctx, params)
+
+	msg := types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000", types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.Equal(t, types.CodeFeeTooLow, res.Code)
+	require.Empty(t, res.Events)
+
+	results, err := types.DecodeDeployResults(res.Data)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, types.DeployErrorFeeTooLow, results[0].ErrorKind)
+
+	params = types.DefaultParams()
+	params.MaxSessionArgsLength = 1
+	input.elk.SetParams(input.ctx, params)
+	res = handler(input.ctx, msg, true, 0, 0)
+	require.Equal(t, types.CodeSessionArgsTooLong, res.Code)
+
+	params = types.DefaultParams()
+	params.MaxDeploySize = 3
+	input.elk.SetParams(input.ctx, params)
+	deploy := types.NewMsgDeployContract(GenesisAccountAddress, "counter", []byte{0x00, 0x61, 0x73, 0x6d}, "", "counter contract", types.BASIC_FEE)
+	res = handler(input.ctx, deploy, true, 0, 0)
+	require.Equal(t, types.CodeDeployTooLarge, res.Code)
+
+	// the default params accept the deploy
+	input.elk.SetParams(input.ctx, types.DefaultParams())
+	res = handler(input.ctx, msg, true, 0, 0)
+	require.True(t, res.IsOK(), res.Log)
+}
+
+func TestHandlerEditValidatorNotFound(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	handler := NewHandler(input.elk)
+
+	msg := types.NewMsgEditValidator(ContractAddress, RecipientAccountAddress, types.Description{}, nil, types.BASIC_FEE)
+	res := handler(input.ctx, msg, true, 0, 0)
+	require.Equal(t, types.CodeInvalidValidator, res.Code)
+	require.Equal(t, types.DefaultCodespace, res.Codespace)
+}
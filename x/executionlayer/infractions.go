@@ -0,0 +1,238 @@
+package executionlayer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+	"github.com/hdac-io/tendermint/crypto"
+)
+
+// DoubleSignJailEndTime is the time a tombstoned validator is jailed until, which is never reached
+var DoubleSignJailEndTime = time.Unix(253402300799, 0).UTC()
+
+// HandleValidatorSignature records whether a validator signed the last block,
+// and jails the validator if it missed too many blocks of the signed blocks window
+func (k ExecutionLayerKeeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, signed bool) {
+	logger := k.Logger(ctx)
+	height := ctx.BlockHeight()
+	consAddr := sdk.ConsAddress(addr)
+
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		logger.Error(fmt.Sprintf("validator consensus-address %s not found", consAddr))
+		return
+	}
+
+	// signing info is created lazily, when the validator is seen at the first time
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		signInfo = types.NewValidatorSigningInfo(consAddr, height, 0, time.Unix(0, 0), false, 0)
+	}
+
+	// this is a relative index, so it counts blocks the validator *should* have signed
+	// will use the 0-value default signing info if not present, except for start height
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	index := signInfo.IndexOffset % signedBlocksWindow
+	signInfo.IndexOffset++
+
+	// Update signed block bit array & counter
+	// This counter just tracks the sum of the bit array
+	// That way we avoid needing to read/write the whole array each time
+	previous := k.getValidatorMissedBlockBitArray(ctx, consAddr, index)
+	missed := !signed
+	switch {
+	case !previous && missed:
+		// Array value has changed from not missed to missed, increment counter
+		k.setValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+		signInfo.MissedBlocksCounter++
+	case previous && !missed:
+		// Array value has changed from missed to not missed, decrement counter
+		k.setValidatorMissedBlockBitArray(ctx, consAddr, index, false)
+		signInfo.MissedBlocksCounter--
+	default:
+		// Array value at this index has not changed, no need to update counter
+	}
+
+	if missed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiveness,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", signInfo.MissedBlocksCounter)),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+			),
+		)
+
+		logger.Info(
+			fmt.Sprintf("Absent validator %s at height %d, %d missed, threshold %d",
+				validator.OperatorAddress, height, signInfo.MissedBlocksCounter, k.MinSignedPerWindow(ctx)))
+	}
+
+	minHeight := signInfo.StartHeight + signedBlocksWindow
+	maxMissed := signedBlocksWindow - k.MinSignedPerWindow(ctx)
+
+	// if we are past the minimum height and the validator has missed too many blocks, jail the validator
+	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
+		if !validator.Jailed {
+			// Jail the validator, then its power is taken out of the validator set at the end block
+			k.jailValidator(ctx, validator)
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+
+			// We need to reset the counter & array so that the validator won't be immediately jailed again upon unjail
+			signInfo.MissedBlocksCounter = 0
+			signInfo.IndexOffset = 0
+			k.clearValidatorMissedBlockBitArray(ctx, consAddr)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeJail,
+					sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
+					sdk.NewAttribute(types.AttributeKeyJailedUntil, signInfo.JailedUntil.String()),
+				),
+			)
+
+			logger.Info(fmt.Sprintf("Validator %s past min height of %d and below signed blocks threshold of %d, jailed until %s",
+				validator.OperatorAddress, minHeight, k.MinSignedPerWindow(ctx), signInfo.JailedUntil))
+		} else {
+			logger.Info(fmt.Sprintf("Validator %s would have been jailed for downtime, but was already jailed",
+				validator.OperatorAddress))
+		}
+	}
+
+	// Set the updated signing info
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
+
+// HandleDoubleSign slashes a fraction of the stake bonded and delegated to a validator which signed
+// conflicting blocks, and tombstones it. The slash is executed by the execution engine on the state of
+// the candidate block, so it must be called after the candidate block is prepared.
+func (k ExecutionLayerKeeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time) {
+	logger := k.Logger(ctx)
+	consAddr := sdk.ConsAddress(addr)
+
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		// Ignore evidence that cannot be handled
+		logger.Error(fmt.Sprintf("Ignored double sign from %s at height %d, validator consensus-address not found",
+			consAddr, infractionHeight))
+		return
+	}
+
+	// Reject evidence if the double-sign is too old
+	age := ctx.BlockHeader().Time.Sub(timestamp)
+	if age > k.MaxEvidenceAge(ctx) {
+		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, age of %v past max age of %v",
+			consAddr, infractionHeight, age, k.MaxEvidenceAge(ctx)))
+		return
+	}
+
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		signInfo = types.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0)
+	}
+
+	// Validator is already tombstoned, so it was slashed for an infraction already
+	if signInfo.Tombstoned {
+		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, validator already tombstoned",
+			consAddr, infractionHeight))
+		return
+	}
+
+	logger.Info(fmt.Sprintf("Confirmed double sign from %s at height %d, age of %v", consAddr, infractionHeight, age))
+
+	fraction := k.SlashFractionDoubleSign(ctx)
+	amount := k.slashValidator(ctx, validator, fraction)
+
+	// Jail the validator forever, then its power is taken out of the validator set at the end block
+	if !validator.Jailed {
+		k.jailValidator(ctx, validator)
+	}
+	signInfo.JailedUntil = DoubleSignJailEndTime
+	signInfo.Tombstoned = true
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	k.SetSlashRecord(ctx, types.NewSlashRecord(validator.OperatorAddress, consAddr, infractionHeight,
+		ctx.BlockHeight(), ctx.BlockHeader().Time, fraction, amount, types.AttributeValueDoubleSign))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", infractionHeight)),
+			sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, signInfo.JailedUntil.String()),
+		),
+	)
+}
+
+// slashValidator slashes a fraction of the current stake of a validator in the PoS contract.
+// The stake is taken from the validator and its delegators in proportion to their delegations.
+// It returns the slashed amount, which is "0" if the execution engine failed to slash.
+func (k ExecutionLayerKeeper) slashValidator(ctx sdk.Context, validator types.Validator, fraction sdk.Dec) string {
+	stake, ok := sdk.NewIntFromString(queryPosStakes(ctx, k)[hex.EncodeToString(validator.OperatorAddress)])
+	if !ok {
+		stake = sdk.ZeroInt()
+	}
+	amount := fraction.MulInt(stake).TruncateInt()
+	if !amount.IsPositive() {
+		return "0"
+	}
+
+	candidateBlock := ctx.CandidateBlock()
+	res, err := k.client.Slash(ctx.Context(), &ipc.SlashRequest{
+		ParentStateHash: candidateBlock.State,
+		Slashes: []*ipc.SlashRequest_ValidatorSlash{
+			{
+				ValidatorId: validator.OperatorAddress,
+				Value:       &state.BigInt{Value: amount.String(), BitWidth: 512},
+			},
+		},
+		ProtocolVersion: candidateBlock.ProtocolVersion,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	switch res.GetResult().(type) {
+	case *ipc.SlashResponse_Success:
+		candidateBlock.State = res.GetSuccess().GetPoststateHash()
+		return amount.String()
+	case *ipc.SlashResponse_MissingParent:
+		panic(fmt.Sprintf("Missing parent : %s", hex.EncodeToString(res.GetMissingParent().GetHash())))
+	default:
+		k.Logger(ctx).Error(fmt.Sprintf("slashing validator %s failed: %s",
+			validator.OperatorAddress, res.GetError().GetMessage()))
+		return "0"
+	}
+}
+
+// jailValidator takes a validator out of the validator set until it is unjailed
+func (k ExecutionLayerKeeper) jailValidator(ctx sdk.Context, validator types.Validator) {
+	validator.Jailed = true
+	k.SetValidator(ctx, validator.OperatorAddress, validator)
+}
+
+// unjailValidator returns a jailed validator to the validator set, and restarts its signed blocks window
+func (k ExecutionLayerKeeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
+	validator.Jailed = false
+	k.SetValidator(ctx, validator.OperatorAddress, validator)
+
+	consAddr := validator.ConsAddress()
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return
+	}
+	signInfo.StartHeight = ctx.BlockHeight()
+	signInfo.IndexOffset = 0
+	signInfo.MissedBlocksCounter = 0
+	k.clearValidatorMissedBlockBitArray(ctx, consAddr)
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
@@ -2,18 +2,20 @@ package executionlayer
 
 import (
 	"fmt"
+	"time"
 
-	"github.com/hdac-io/casperlabs-ee-grpc-go-util/grpc"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
 
 	"github.com/hdac-io/tendermint/crypto"
+	"github.com/hdac-io/tendermint/libs/log"
 
 	"github.com/hdac-io/friday/codec"
 	sdk "github.com/hdac-io/friday/types"
 	"github.com/hdac-io/friday/x/auth"
 	"github.com/hdac-io/friday/x/executionlayer/types"
 	"github.com/hdac-io/friday/x/nickname"
+	"github.com/hdac-io/friday/x/params"
 )
 
 type ExecutionLayerKeeper struct {
@@ -21,23 +23,31 @@ type ExecutionLayerKeeper struct {
 	client          ipc.ExecutionEngineServiceClient
 	AccountKeeper   auth.AccountKeeper
 	NicknameKeeper  nickname.NicknameKeeper
+	paramSpace      params.Subspace
 	cdc             *codec.Codec
 }
 
 func NewExecutionLayerKeeper(
-	cdc *codec.Codec, hashMapStoreKey sdk.StoreKey, path string,
+	cdc *codec.Codec, hashMapStoreKey sdk.StoreKey, paramSpace params.Subspace,
+	engineConfig types.EngineConfig, logger log.Logger,
 	accountKeeper auth.AccountKeeper,
 	nicknameKeeper nickname.NicknameKeeper) ExecutionLayerKeeper {
 
 	return ExecutionLayerKeeper{
 		HashMapStoreKey: hashMapStoreKey,
-		client:          grpc.Connect(path),
+		paramSpace:      paramSpace.WithKeyTable(types.ParamKeyTable()),
+		client:          NewEngineClient(engineConfig, logger),
 		AccountKeeper:   accountKeeper,
 		NicknameKeeper:  nicknameKeeper,
 		cdc:             cdc,
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k ExecutionLayerKeeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // -----------------------------------------------------------------------------------------------------------
 
 // SetUnitHashMap map unitHash to blockHash
@@ -248,3 +258,142 @@ func (k ExecutionLayerKeeper) SetProtocolVersion(ctx sdk.Context, protocolVersio
 	protocolVersionBytes := k.cdc.MustMarshalBinaryBare(protocolVersion)
 	store.Set([]byte(types.ProtoclVersionKey), protocolVersionBytes)
 }
+
+// -----------------------------------------------------------------------------------------------------------
+
+// GetRegisteredContract returns the contract registered as name
+func (k ExecutionLayerKeeper) GetRegisteredContract(ctx sdk.Context, name string) (contract types.RegisteredContract, found bool) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	contractBytes := store.Get(types.GetRegisteredContractKey(name))
+	if contractBytes == nil {
+		return contract, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(contractBytes, &contract)
+	return contract, true
+}
+
+// SetRegisteredContract registers a contract under its name and indexes it by its deployer
+func (k ExecutionLayerKeeper) SetRegisteredContract(ctx sdk.Context, contract types.RegisteredContract) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	store.Set(types.GetRegisteredContractKey(contract.Name), k.cdc.MustMarshalBinaryBare(contract))
+	store.Set(types.GetRegisteredContractByDeployerKey(contract.Deployer, contract.Name), []byte{})
+}
+
+// GetRegisteredContractsByDeployer returns the contracts registered by deployer, sorted by name
+func (k ExecutionLayerKeeper) GetRegisteredContractsByDeployer(ctx sdk.Context, deployer sdk.AccAddress) types.RegisteredContracts {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	prefix := types.GetRegisteredContractsByDeployerKey(deployer)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	contracts := types.RegisteredContracts{}
+	for ; iterator.Valid(); iterator.Next() {
+		if contract, found := k.GetRegisteredContract(ctx, string(iterator.Key()[len(prefix):])); found {
+			contracts = append(contracts, contract)
+		}
+	}
+	return contracts
+}
+
+// GetAllRegisteredContracts returns every registered contract, sorted by name
+func (k ExecutionLayerKeeper) GetAllRegisteredContracts(ctx sdk.Context) types.RegisteredContracts {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RegisteredContractKey)
+	defer iterator.Close()
+
+	contracts := types.RegisteredContracts{}
+	for ; iterator.Valid(); iterator.Next() {
+		var contract types.RegisteredContract
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &contract)
+		contracts = append(contracts, contract)
+	}
+	return contracts
+}
+
+// -----------------------------------------------------------------------------------------------------------
+
+// GetScheduledEngineUpgrade returns the engine upgrade scheduled by governance
+func (k ExecutionLayerKeeper) GetScheduledEngineUpgrade(ctx sdk.Context) (upgrade types.EngineUpgradeProposal, found bool) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	bz := store.Get(types.ScheduledEngineUpgradeKey)
+	if bz == nil {
+		return upgrade, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &upgrade)
+	return upgrade, true
+}
+
+// SetScheduledEngineUpgrade schedules the engine upgrade, replacing the one scheduled before
+func (k ExecutionLayerKeeper) SetScheduledEngineUpgrade(ctx sdk.Context, upgrade types.EngineUpgradeProposal) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	store.Set(types.ScheduledEngineUpgradeKey, k.cdc.MustMarshalBinaryBare(upgrade))
+}
+
+// DeleteScheduledEngineUpgrade removes the scheduled engine upgrade
+func (k ExecutionLayerKeeper) DeleteScheduledEngineUpgrade(ctx sdk.Context) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	store.Delete(types.ScheduledEngineUpgradeKey)
+}
+
+// SetAppliedEngineUpgrade records the engine upgrade applied at its height
+func (k ExecutionLayerKeeper) SetAppliedEngineUpgrade(ctx sdk.Context, upgrade types.EngineUpgrade) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	store.Set(types.GetAppliedEngineUpgradeKey(upgrade.Height), k.cdc.MustMarshalBinaryBare(upgrade))
+}
+
+// GetAppliedEngineUpgrades returns the engine upgrades applied, in order of height
+func (k ExecutionLayerKeeper) GetAppliedEngineUpgrades(ctx sdk.Context) []types.EngineUpgrade {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AppliedEngineUpgradeKey)
+	defer iterator.Close()
+
+	upgrades := []types.EngineUpgrade{}
+	for ; iterator.Valid(); iterator.Next() {
+		var upgrade types.EngineUpgrade
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &upgrade)
+		upgrades = append(upgrades, upgrade)
+	}
+	return upgrades
+}
+
+// -----------------------------------------------------------------------------------------------------------
+
+// SetExecutedDeploy records the height where the deploy was executed,
+// indexed by the block time for pruning
+func (k ExecutionLayerKeeper) SetExecutedDeploy(ctx sdk.Context, deployHash []byte, height int64) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	store.Set(types.GetExecutedDeployKey(deployHash), k.cdc.MustMarshalBinaryBare(height))
+	store.Set(types.GetExecutedDeployByTimeKey(ctx.BlockTime(), deployHash), []byte{})
+}
+
+// PruneExecutedDeploys deletes the deploys executed before the given time
+func (k ExecutionLayerKeeper) PruneExecutedDeploys(ctx sdk.Context, before time.Time) {
+	// no deploy was executed before the epoch
+	if before.Unix() <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.HashMapStoreKey)
+	iterator := store.Iterator(types.ExecutedDeployByTimeKey, types.GetExecutedDeploysByTimeKey(before))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	prefixLen := len(types.ExecutedDeployByTimeKey) + 8
+	for _, key := range keys {
+		store.Delete(types.GetExecutedDeployKey(key[prefixLen:]))
+		store.Delete(key)
+	}
+}
+
+// GetExecutedDeploy returns the height where the deploy was executed
+func (k ExecutionLayerKeeper) GetExecutedDeploy(ctx sdk.Context, deployHash []byte) (height int64, found bool) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	bz := store.Get(types.GetExecutedDeployKey(deployHash))
+	if bz == nil {
+		return 0, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &height)
+	return height, true
+}
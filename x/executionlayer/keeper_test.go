@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
@@ -164,3 +165,55 @@ func TestProtocolVersionKeeper(t *testing.T) {
 
 	assert.Equal(t, src, res)
 }
+
+func TestRegisteredContractKeeper(t *testing.T) {
+	input := setupTestInput()
+
+	_, found := input.elk.GetRegisteredContract(input.ctx, "counter")
+	assert.False(t, found)
+
+	counter := types.NewRegisteredContract("counter", GenesisAccountAddress, "00", "fridaycontracthash1", 1, "")
+	token := types.NewRegisteredContract("token", GenesisAccountAddress, "01", "fridaycontracthash2", 2, "erc20")
+	other := types.NewRegisteredContract("other", RecipientAccountAddress, "02", "fridaycontracthash3", 3, "")
+	input.elk.SetRegisteredContract(input.ctx, counter)
+	input.elk.SetRegisteredContract(input.ctx, token)
+	input.elk.SetRegisteredContract(input.ctx, other)
+
+	res, found := input.elk.GetRegisteredContract(input.ctx, "token")
+	assert.True(t, found)
+	assert.Equal(t, token, res)
+
+	assert.Equal(t, types.RegisteredContracts{counter, token}, input.elk.GetRegisteredContractsByDeployer(input.ctx, GenesisAccountAddress))
+	assert.Equal(t, types.RegisteredContracts{other}, input.elk.GetRegisteredContractsByDeployer(input.ctx, RecipientAccountAddress))
+	assert.Equal(t, types.RegisteredContracts{counter, other, token}, input.elk.GetAllRegisteredContracts(input.ctx))
+}
+
+func TestParamsUpdateValidated(t *testing.T) {
+	input := setupTestInput()
+
+	// a parameter change is validated on the whole set, as a governance proposal does
+	assert.Error(t, input.elk.paramSpace.Update(input.ctx, types.KeySignedBlocksWindow, []byte(`"0"`)))
+	assert.Error(t, input.elk.paramSpace.Update(input.ctx, types.KeyMaxCommissionRate, []byte(`"2.0"`)))
+	assert.Equal(t, types.DefaultSignedBlocksWindow, input.elk.SignedBlocksWindow(input.ctx))
+
+	assert.NoError(t, input.elk.paramSpace.Update(input.ctx, types.KeySignedBlocksWindow, []byte(`"10"`)))
+	assert.Equal(t, int64(10), input.elk.SignedBlocksWindow(input.ctx))
+}
+
+func TestPruneExecutedDeploys(t *testing.T) {
+	input := setupTestInput()
+	blockTime := time.Unix(1600000000, 0)
+
+	old := []byte("old deploy")
+	recent := []byte("recent deploy")
+	input.elk.SetExecutedDeploy(input.ctx.WithBlockTime(blockTime), old, 1)
+	input.elk.SetExecutedDeploy(input.ctx.WithBlockTime(blockTime.Add(time.Hour)), recent, 2)
+
+	input.elk.PruneExecutedDeploys(input.ctx, blockTime.Add(time.Minute))
+
+	_, found := input.elk.GetExecutedDeploy(input.ctx, old)
+	assert.False(t, found)
+	height, found := input.elk.GetExecutedDeploy(input.ctx, recent)
+	assert.True(t, found)
+	assert.Equal(t, int64(2), height)
+}
@@ -0,0 +1,292 @@
+/*
+Package mock provides an in-process execution engine, which implements
+ipc.ExecutionEngineServiceClient without the CasperLabs execution engine.
+
+It keeps balances, bonds, delegations, votes, rewards and commissions in memory,
+and interprets the calls of the client api proxy contract and the standard payment.
+Wasm sessions are not interpreted; they pay for the deploy and are stored
+as a contract at the hash of the deploy.
+Every deploy costs DeployCost gas.
+
+The effects of a deploy are the deltas of the world state, so Commit replays them
+on the prestate. Every state hash committed is kept in memory.
+*/
+package mock
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/storedvalue"
+	"google.golang.org/grpc"
+)
+
+var _ ipc.ExecutionEngineServiceClient = &ExecutionEngine{}
+
+// ExecutionEngine is an in-memory execution engine
+type ExecutionEngine struct {
+	mtx    sync.RWMutex
+	states map[string]world
+}
+
+// NewExecutionEngine returns a new in-memory execution engine
+func NewExecutionEngine() *ExecutionEngine {
+	return &ExecutionEngine{
+		states: map[string]world{},
+	}
+}
+
+func (e *ExecutionEngine) getState(stateHash []byte) (world, bool) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	w, ok := e.states[hex.EncodeToString(stateHash)]
+	if !ok {
+		return nil, false
+	}
+	return w.copy(), true
+}
+
+func (e *ExecutionEngine) putState(w world) []byte {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	stateHash := w.hash()
+	e.states[hex.EncodeToString(stateHash)] = w
+	return stateHash
+}
+
+// RunGenesis creates the genesis accounts with their balances and bonds,
+// and restores the PoS state from the state infos of the genesis config.
+func (e *ExecutionEngine) RunGenesis(ctx context.Context, in *ipc.ChainSpec_GenesisConfig, opts ...grpc.CallOption) (*ipc.GenesisResponse, error) {
+	w := world{}
+	failure := func(msg string) *ipc.GenesisResponse {
+		return &ipc.GenesisResponse{Result: &ipc.GenesisResponse_FailedDeploy{FailedDeploy: &ipc.GenesisDeployError{Message: msg}}}
+	}
+
+	for _, account := range in.GetAccounts() {
+		balance, ok := new(big.Int).SetString(account.GetBalance().GetValue(), 10)
+		if !ok {
+			return failure(fmt.Sprintf("invalid balance of %s", hex.EncodeToString(account.GetPublicKey()))), nil
+		}
+		w[balanceKey(account.GetPublicKey())] = balance
+
+		if bonded, ok := new(big.Int).SetString(account.GetBondedAmount().GetValue(), 10); ok && bonded.Sign() > 0 {
+			w[delegationKey(account.GetPublicKey(), account.GetPublicKey())] = bonded
+		}
+	}
+
+	if err := w.restore(in.GetStateInfos()); err != nil {
+		return failure(err.Error()), nil
+	}
+
+	return &ipc.GenesisResponse{Result: &ipc.GenesisResponse_Success{Success: &ipc.GenesisResult{
+		PoststateHash: e.putState(w),
+		Effect:        &ipc.ExecutionEffect{},
+	}}}, nil
+}
+
+// restore applies the named keys of the PoS contract, which are exported in the genesis.
+// The delegations in state infos replace the bonds of the genesis accounts.
+func (w world) restore(stateInfos []string) error {
+	delegations := world{}
+	for _, info := range stateInfos {
+		values := strings.Split(info, "_")
+		if len(values) < 2 {
+			return fmt.Errorf("invalid state info: %s", info)
+		}
+		amount, ok := new(big.Int).SetString(values[len(values)-1], 10)
+		if !ok {
+			return fmt.Errorf("invalid state info: %s", info)
+		}
+
+		addrs := [][]byte{}
+		for _, value := range values[1 : len(values)-1] {
+			addr, err := hex.DecodeString(value)
+			if err != nil {
+				return fmt.Errorf("invalid state info: %s", info)
+			}
+			addrs = append(addrs, addr)
+		}
+
+		switch {
+		case values[0] == storedvalue.DELEGATE_PREFIX && len(addrs) == 2:
+			delegations[delegationKey(addrs[0], addrs[1])] = amount
+		case values[0] == storedvalue.VOTE_PREFIX && len(addrs) == 2:
+			w[voteKey(addrs[0], addrs[1])] = amount
+		case values[0] == storedvalue.REWARD_PREFIX && len(addrs) == 1:
+			w[rewardKey(addrs[0])] = amount
+		case values[0] == storedvalue.COMMISSION_PREFIX && len(addrs) == 1:
+			w[commissionKey(addrs[0])] = amount
+		case values[0] == storedvalue.VALIDATOR_PREFIX:
+			// validator stakes are the sum of delegations
+		default:
+			return fmt.Errorf("invalid state info: %s", info)
+		}
+	}
+
+	if len(delegations) > 0 {
+		keys, _ := w.entries(delegationPrefix)
+		for _, k := range keys {
+			delete(w, strings.Join(append([]string{delegationPrefix}, k...), keySeparator))
+		}
+		for k, v := range delegations {
+			w[k] = v
+		}
+	}
+	return nil
+}
+
+// Execute runs the deploys in order on the parent state
+func (e *ExecutionEngine) Execute(ctx context.Context, in *ipc.ExecuteRequest, opts ...grpc.CallOption) (*ipc.ExecuteResponse, error) {
+	w, ok := e.getState(in.GetParentStateHash())
+	if !ok {
+		return &ipc.ExecuteResponse{Result: &ipc.ExecuteResponse_MissingParent{MissingParent: &ipc.RootNotFound{Hash: in.GetParentStateHash()}}}, nil
+	}
+
+	results := []*ipc.DeployResult{}
+	for _, deploy := range in.GetDeploys() {
+		results = append(results, w.executeDeploy(deploy))
+	}
+
+	return &ipc.ExecuteResponse{Result: &ipc.ExecuteResponse_Success{Success: &ipc.ExecResult{DeployResults: results}}}, nil
+}
+
+// Commit applies the effects returned by Execute on the prestate
+func (e *ExecutionEngine) Commit(ctx context.Context, in *ipc.CommitRequest, opts ...grpc.CallOption) (*ipc.CommitResponse, error) {
+	w, ok := e.getState(in.GetPrestateHash())
+	if !ok {
+		return &ipc.CommitResponse{Result: &ipc.CommitResponse_MissingPrestate{MissingPrestate: &ipc.RootNotFound{Hash: in.GetPrestateHash()}}}, nil
+	}
+
+	ops, err := effectsToOps(in.GetEffects())
+	if err == nil {
+		err = w.apply(ops)
+	}
+	if err != nil {
+		return &ipc.CommitResponse{Result: &ipc.CommitResponse_FailedTransform{FailedTransform: &ipc.PostEffectsError{Message: err.Error()}}}, nil
+	}
+
+	return &ipc.CommitResponse{Result: &ipc.CommitResponse_Success{Success: &ipc.CommitResult{
+		PoststateHash:    e.putState(w),
+		BondedValidators: w.bonds(),
+	}}}, nil
+}
+
+// Query returns the stored value of the key in the same format with the execution engine
+func (e *ExecutionEngine) Query(ctx context.Context, in *ipc.QueryRequest, opts ...grpc.CallOption) (*ipc.QueryResponse, error) {
+	w, ok := e.getState(in.GetStateHash())
+	if !ok {
+		return &ipc.QueryResponse{Result: &ipc.QueryResponse_Failure{Failure: fmt.Sprintf("Root not found: %s", hex.EncodeToString(in.GetStateHash()))}}, nil
+	}
+
+	res, err := w.query(in.GetBaseKey(), in.GetPath(), in.GetProtocolVersion())
+	if err != nil {
+		return &ipc.QueryResponse{Result: &ipc.QueryResponse_Failure{Failure: err.Error()}}, nil
+	}
+	return &ipc.QueryResponse{Result: &ipc.QueryResponse_Success{Success: res}}, nil
+}
+
+// Upgrade does not change the state
+func (e *ExecutionEngine) Upgrade(ctx context.Context, in *ipc.UpgradeRequest, opts ...grpc.CallOption) (*ipc.UpgradeResponse, error) {
+	if _, ok := e.getState(in.GetParentStateHash()); !ok {
+		return &ipc.UpgradeResponse{Result: &ipc.UpgradeResponse_FailedDeploy{FailedDeploy: &ipc.UpgradeDeployError{
+			Message: fmt.Sprintf("Root not found: %s", hex.EncodeToString(in.GetParentStateHash()))}}}, nil
+	}
+
+	return &ipc.UpgradeResponse{Result: &ipc.UpgradeResponse_Success{Success: &ipc.UpgradeResult{
+		PostStateHash: in.GetParentStateHash(),
+		Effect:        &ipc.ExecutionEffect{},
+	}}}, nil
+}
+
+// BidState returns the stakes of validators
+func (e *ExecutionEngine) BidState(ctx context.Context, in *ipc.BidStateRequest, opts ...grpc.CallOption) (*ipc.BidStateResponse, error) {
+	w, ok := e.getState(in.GetParentStateHash())
+	if !ok {
+		return &ipc.BidStateResponse{Result: &ipc.BidStateResponse_MissingParent{MissingParent: &ipc.RootNotFound{Hash: in.GetParentStateHash()}}}, nil
+	}
+
+	bids := []*ipc.BidState_Bid{}
+	for _, bond := range w.bonds() {
+		bids = append(bids, &ipc.BidState_Bid{Id: bond.GetValidatorPublicKey(), Value: bond.GetStake()})
+	}
+	return &ipc.BidStateResponse{Result: &ipc.BidStateResponse_Success{Success: &ipc.BidState{Bids: bids}}}, nil
+}
+
+// DistributeRewards adds the rewards to validators
+func (e *ExecutionEngine) DistributeRewards(ctx context.Context, in *ipc.DistributeRewardsRequest, opts ...grpc.CallOption) (*ipc.DistributeRewardsResponse, error) {
+	w, ok := e.getState(in.GetParentStateHash())
+	if !ok {
+		return &ipc.DistributeRewardsResponse{Result: &ipc.DistributeRewardsResponse_MissingParent{MissingParent: &ipc.RootNotFound{Hash: in.GetParentStateHash()}}}, nil
+	}
+
+	ops := []op{}
+	for _, reward := range in.GetRewards() {
+		value, ok := new(big.Int).SetString(reward.GetValue().GetValue(), 10)
+		if !ok || value.Sign() < 0 {
+			return &ipc.DistributeRewardsResponse{Result: &ipc.DistributeRewardsResponse_Error{Error: &ipc.DistibuteRewardsError{
+				Message: fmt.Sprintf("invalid reward: %s", reward.GetValue().GetValue())}}}, nil
+		}
+		ops = append(ops, op{key: rewardKey(reward.GetValidatorId()), delta: value})
+	}
+	w.apply(ops)
+
+	return &ipc.DistributeRewardsResponse{Result: &ipc.DistributeRewardsResponse_Success{Success: &ipc.CommitResult{
+		PoststateHash:    e.putState(w),
+		BondedValidators: w.bonds(),
+	}}}, nil
+}
+
+// Slash takes the value from the bond of validators and the delegations to them
+func (e *ExecutionEngine) Slash(ctx context.Context, in *ipc.SlashRequest, opts ...grpc.CallOption) (*ipc.SlashResponse, error) {
+	w, ok := e.getState(in.GetParentStateHash())
+	if !ok {
+		return &ipc.SlashResponse{Result: &ipc.SlashResponse_MissingParent{MissingParent: &ipc.RootNotFound{Hash: in.GetParentStateHash()}}}, nil
+	}
+
+	for _, slash := range in.GetSlashes() {
+		value, ok := new(big.Int).SetString(slash.GetValue().GetValue(), 10)
+		if !ok || value.Sign() < 0 {
+			return &ipc.SlashResponse{Result: &ipc.SlashResponse_Error{Error: &ipc.SlashError{
+				Message: fmt.Sprintf("invalid slash: %s", slash.GetValue().GetValue())}}}, nil
+		}
+
+		w.apply(w.slash(slash.GetValidatorId(), value))
+	}
+
+	return &ipc.SlashResponse{Result: &ipc.SlashResponse_Success{Success: &ipc.CommitResult{
+		PoststateHash:    e.putState(w),
+		BondedValidators: w.bonds(),
+	}}}, nil
+}
+
+// UnbondPayout does nothing, because unbonding is paid out immediately
+func (e *ExecutionEngine) UnbondPayout(ctx context.Context, in *ipc.UnbondPayoutRequest, opts ...grpc.CallOption) (*ipc.UnbondPayoutResponse, error) {
+	w, ok := e.getState(in.GetParentStateHash())
+	if !ok {
+		return &ipc.UnbondPayoutResponse{Result: &ipc.UnbondPayoutResponse_MissingParent{MissingParent: &ipc.RootNotFound{Hash: in.GetParentStateHash()}}}, nil
+	}
+
+	return &ipc.UnbondPayoutResponse{Result: &ipc.UnbondPayoutResponse_Success{Success: &ipc.CommitResult{
+		PoststateHash:    in.GetParentStateHash(),
+		BondedValidators: w.bonds(),
+	}}}, nil
+}
+
+// Step does not change the state
+func (e *ExecutionEngine) Step(ctx context.Context, in *ipc.StepRequest, opts ...grpc.CallOption) (*ipc.StepResponse, error) {
+	if _, ok := e.getState(in.GetParentStateHash()); !ok {
+		return &ipc.StepResponse{Result: &ipc.StepResponse_MissingParent{MissingParent: &ipc.RootNotFound{Hash: in.GetParentStateHash()}}}, nil
+	}
+
+	return &ipc.StepResponse{Result: &ipc.StepResponse_Success{Success: &ipc.StepResult{
+		PostStateHash: in.GetParentStateHash(),
+		Effect:        &ipc.ExecutionEffect{},
+	}}}, nil
+}
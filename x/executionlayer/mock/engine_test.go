@@ -0,0 +1,326 @@
+package mock
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/grpc"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/storedvalue"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	protocolVersion = &state.ProtocolVersion{Major: 1}
+
+	addr1 = append(make([]byte, 31), 1)
+	addr2 = append(make([]byte, 31), 2)
+)
+
+func strArg(str string) *consensus.Deploy_Arg {
+	return &consensus.Deploy_Arg{Value: &state.CLValueInstance{
+		ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_StrValue{StrValue: str}}}}
+}
+
+func bytesArg(bytes []byte) *consensus.Deploy_Arg {
+	return &consensus.Deploy_Arg{Value: &state.CLValueInstance{
+		ClType: &state.CLType{Variants: &state.CLType_ListType{ListType: &state.CLType_List{Inner: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U8}}}}},
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_BytesValue{BytesValue: bytes}}}}
+}
+
+func u512Arg(amount string) *consensus.Deploy_Arg {
+	return &consensus.Deploy_Arg{Value: &state.CLValueInstance{
+		ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_U512{U512: &state.CLValueInstance_U512{Value: amount}}}}}
+}
+
+func proxyDeploy(t *testing.T, from []byte, fee string, sessionArgs ...*consensus.Deploy_Arg) *ipc.DeployItem {
+	sessionAbi, err := util.AbiDeployArgsTobytes(sessionArgs)
+	require.Nil(t, err)
+	paymentAbi, err := util.AbiDeployArgsTobytes([]*consensus.Deploy_Arg{strArg(types.PaymentMethodName), u512Arg(fee)})
+	require.Nil(t, err)
+
+	return &ipc.DeployItem{
+		Address:           from,
+		Session:           util.MakeDeployPayload(util.HASH, proxyContractHash, sessionAbi),
+		Payment:           util.MakeDeployPayload(util.HASH, proxyContractHash, paymentAbi),
+		AuthorizationKeys: [][]byte{from},
+		GasPrice:          types.BASIC_GAS,
+	}
+}
+
+func genesis(t *testing.T, engine *ExecutionEngine) []byte {
+	res, err := grpc.RunGenesis(engine, &ipc.ChainSpec_GenesisConfig{
+		ProtocolVersion: protocolVersion,
+		Accounts: []*ipc.ChainSpec_GenesisAccount{
+			{PublicKey: addr1, Balance: &state.BigInt{Value: "5000000000000000000", BitWidth: 512}, BondedAmount: &state.BigInt{Value: "1000000000000000000", BitWidth: 512}},
+		},
+	})
+	require.Nil(t, err)
+	require.NotNil(t, res.GetSuccess())
+	return res.GetSuccess().GetPoststateHash()
+}
+
+func executeAndCommit(t *testing.T, engine *ExecutionEngine, stateHash []byte, deploy *ipc.DeployItem) ([]byte, *ipc.DeployResult) {
+	res, err := grpc.Execute(engine, stateHash, 0, []*ipc.DeployItem{deploy}, protocolVersion)
+	require.Nil(t, err)
+	require.NotNil(t, res.GetSuccess())
+	result := res.GetSuccess().GetDeployResults()[0]
+
+	postStateHash, _, errMessage := grpc.Commit(engine, stateHash, result.GetExecutionResult().GetEffects().GetTransformMap(), protocolVersion)
+	require.Equal(t, "", errMessage)
+	return postStateHash, result
+}
+
+func TestTransfer(t *testing.T) {
+	engine := NewExecutionEngine()
+	stateHash := genesis(t, engine)
+
+	balance, errMessage := grpc.QueryBalance(engine, stateHash, addr1, protocolVersion)
+	require.Equal(t, "", errMessage)
+	require.Equal(t, "5000000000000000000", balance)
+
+	deploy := proxyDeploy(t, addr1, types.BASIC_FEE, strArg(types.TransferMethodName), bytesArg(addr2), u512Arg("1000000000000000000"))
+	stateHash, result := executeAndCommit(t, engine, stateHash, deploy)
+	require.Nil(t, result.GetExecutionResult().GetError())
+	require.Equal(t, "100000000", result.GetExecutionResult().GetCost().GetValue())
+
+	balance, errMessage = grpc.QueryBalance(engine, stateHash, addr1, protocolVersion)
+	require.Equal(t, "", errMessage)
+	require.Equal(t, "3999999999000000000", balance)
+	balance, errMessage = grpc.QueryBalance(engine, stateHash, addr2, protocolVersion)
+	require.Equal(t, "", errMessage)
+	require.Equal(t, "1000000000000000000", balance)
+
+	// insufficient balance only charges the fee
+	deploy = proxyDeploy(t, addr2, types.BASIC_FEE, strArg(types.TransferMethodName), bytesArg(addr1), u512Arg("2000000000000000000"))
+	stateHash, result = executeAndCommit(t, engine, stateHash, deploy)
+	require.NotNil(t, result.GetExecutionResult().GetError().GetExecError())
+	balance, _ = grpc.QueryBalance(engine, stateHash, addr2, protocolVersion)
+	require.Equal(t, "999999999000000000", balance)
+}
+
+func TestDelegatedPayment(t *testing.T) {
+	engine := NewExecutionEngine()
+	stateHash := genesis(t, engine)
+
+	deploy := proxyDeploy(t, addr1, types.BASIC_FEE, strArg(types.TransferMethodName), bytesArg(addr2), u512Arg("1000000000000000000"))
+	stateHash, _ = executeAndCommit(t, engine, stateHash, deploy)
+
+	// addr1 pays the fee of the transfer of addr2
+	deploy = proxyDeploy(t, addr2, types.BASIC_FEE, strArg(types.TransferMethodName), bytesArg(addr1), u512Arg("1000"))
+	paymentAbi, err := util.AbiDeployArgsTobytes([]*consensus.Deploy_Arg{strArg(types.DelegatedPaymentMethodName), bytesArg(addr1), u512Arg(types.BASIC_FEE)})
+	require.Nil(t, err)
+	deploy.Payment = util.MakeDeployPayload(util.HASH, proxyContractHash, paymentAbi)
+	deploy.AuthorizationKeys = [][]byte{addr2, addr1}
+	stateHash, result := executeAndCommit(t, engine, stateHash, deploy)
+	require.Nil(t, result.GetExecutionResult().GetError())
+
+	balance, _ := grpc.QueryBalance(engine, stateHash, addr1, protocolVersion)
+	require.Equal(t, "3999999998000001000", balance)
+	balance, _ = grpc.QueryBalance(engine, stateHash, addr2, protocolVersion)
+	require.Equal(t, "999999999999999000", balance)
+
+	// the payer must authorize the deploy
+	deploy.AuthorizationKeys = [][]byte{addr2}
+	res, err := grpc.Execute(engine, stateHash, 0, []*ipc.DeployItem{deploy}, protocolVersion)
+	require.Nil(t, err)
+	require.NotNil(t, res.GetSuccess().GetDeployResults()[0].GetPreconditionFailure())
+}
+
+func u8Arg(value int32) *consensus.Deploy_Arg {
+	return &consensus.Deploy_Arg{Value: &state.CLValueInstance{
+		ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U8}},
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_U8{U8: value}}}}
+}
+
+func TestAssociatedKeys(t *testing.T) {
+	engine := NewExecutionEngine()
+	stateHash := genesis(t, engine)
+
+	// both keys are required to deploy and to manage the keys
+	stateHash, result := executeAndCommit(t, engine, stateHash,
+		proxyDeploy(t, addr1, types.BASIC_FEE, strArg(types.AddAssociatedKeyMethodName), bytesArg(addr2), u8Arg(1)))
+	require.Nil(t, result.GetExecutionResult().GetError())
+	stateHash, result = executeAndCommit(t, engine, stateHash,
+		proxyDeploy(t, addr1, types.BASIC_FEE, strArg(types.SetActionThresholdsMethodName), u8Arg(2), u8Arg(2)))
+	require.Nil(t, result.GetExecutionResult().GetError())
+
+	res, errMessage := grpc.Query(engine, stateHash, grpc.STR_ADDRESS, addr1, []string{}, protocolVersion)
+	require.Equal(t, "", errMessage)
+	var sv storedvalue.StoredValue
+	sv, err, _ := sv.FromBytes(res)
+	require.Nil(t, err)
+	require.Equal(t, []storedvalue.AssociatedKey{{PublicKey: addr1, Weight: 1}, {PublicKey: addr2, Weight: 1}}, sv.Account.AssociatedKeys)
+	require.Equal(t, storedvalue.ActionThresholds{DeploymentThreshold: 2, KeyManagementThreshold: 2}, sv.Account.ActionThresholds)
+
+	// a single key doesn't authorize a deploy any more
+	deploy := proxyDeploy(t, addr1, types.BASIC_FEE, strArg(types.TransferMethodName), bytesArg(addr2), u512Arg("1000"))
+	execRes, err := grpc.Execute(engine, stateHash, 0, []*ipc.DeployItem{deploy}, protocolVersion)
+	require.Nil(t, err)
+	require.NotNil(t, execRes.GetSuccess().GetDeployResults()[0].GetPreconditionFailure())
+
+	deploy.AuthorizationKeys = [][]byte{addr1, addr2}
+	stateHash, result = executeAndCommit(t, engine, stateHash, deploy)
+	require.Nil(t, result.GetExecutionResult().GetError())
+
+	// the remaining weight can't go below the key management threshold
+	deploy = proxyDeploy(t, addr1, types.BASIC_FEE, strArg(types.RemoveAssociatedKeyMethodName), bytesArg(addr2))
+	deploy.AuthorizationKeys = [][]byte{addr1, addr2}
+	_, result = executeAndCommit(t, engine, stateHash, deploy)
+	require.NotNil(t, result.GetExecutionResult().GetError().GetExecError())
+}
+
+func TestGasError(t *testing.T) {
+	engine := NewExecutionEngine()
+	stateHash := genesis(t, engine)
+
+	deploy := proxyDeploy(t, addr1, "1", strArg(types.TransferMethodName), bytesArg(addr2), u512Arg("1"))
+	stateHash, result := executeAndCommit(t, engine, stateHash, deploy)
+	require.NotNil(t, result.GetExecutionResult().GetError().GetGasError())
+
+	balance, _ := grpc.QueryBalance(engine, stateHash, addr1, protocolVersion)
+	require.Equal(t, "4999999999999999999", balance)
+}
+
+func TestPreconditionFailure(t *testing.T) {
+	engine := NewExecutionEngine()
+	stateHash := genesis(t, engine)
+
+	deploy := proxyDeploy(t, addr2, types.BASIC_FEE, strArg(types.TransferMethodName), bytesArg(addr1), u512Arg("1"))
+	res, err := grpc.Execute(engine, stateHash, 0, []*ipc.DeployItem{deploy}, protocolVersion)
+	require.Nil(t, err)
+	require.NotNil(t, res.GetSuccess().GetDeployResults()[0].GetPreconditionFailure())
+}
+
+func TestMissingParent(t *testing.T) {
+	engine := NewExecutionEngine()
+	genesis(t, engine)
+
+	res, err := grpc.Execute(engine, make([]byte, 32), 0, []*ipc.DeployItem{}, protocolVersion)
+	require.Nil(t, err)
+	require.NotNil(t, res.GetMissingParent())
+
+	step, err := engine.Step(context.Background(), &ipc.StepRequest{ParentStateHash: make([]byte, 32)})
+	require.Nil(t, err)
+	require.NotNil(t, step.GetMissingParent())
+}
+
+func TestDelegateAndVote(t *testing.T) {
+	engine := NewExecutionEngine()
+	stateHash := genesis(t, engine)
+
+	stateHash, _ = executeAndCommit(t, engine, stateHash,
+		proxyDeploy(t, addr1, types.BASIC_FEE, strArg(types.TransferMethodName), bytesArg(addr2), u512Arg("2000000000000000000")))
+	stateHash, result := executeAndCommit(t, engine, stateHash,
+		proxyDeploy(t, addr2, types.BASIC_FEE, strArg(types.DelegateMethodName), bytesArg(addr1), u512Arg("1000000000000000000")))
+	require.Nil(t, result.GetExecutionResult().GetError())
+
+	// addr2 is not a validator
+	stateHash, result = executeAndCommit(t, engine, stateHash,
+		proxyDeploy(t, addr1, types.BASIC_FEE, strArg(types.DelegateMethodName), bytesArg(addr2), u512Arg("1")))
+	require.NotNil(t, result.GetExecutionResult().GetError().GetExecError())
+
+	dapp := storedvalue.NewKeyFromHash(proxyContractHash).ToBytes()
+	voteArg := &consensus.Deploy_Arg{Value: &state.CLValueInstance{
+		ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_KEY}},
+		Value: &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_Key{
+			Key: &state.Key{Value: &state.Key_Hash_{Hash: &state.Key_Hash{Hash: proxyContractHash}}}}}}}
+	stateHash, result = executeAndCommit(t, engine, stateHash,
+		proxyDeploy(t, addr2, types.BASIC_FEE, strArg(types.VoteMethodName), voteArg, u512Arg("400000000000000000")))
+	require.Nil(t, result.GetExecutionResult().GetError())
+
+	res, errMessage := grpc.Query(engine, stateHash, grpc.STR_ADDRESS, grpc.SYSTEM_ACCOUNT, []string{types.PosContractName}, protocolVersion)
+	require.Equal(t, "", errMessage)
+	var sv storedvalue.StoredValue
+	sv, err, _ := sv.FromBytes(res)
+	require.Nil(t, err)
+	require.Equal(t, "2000000000000000000", sv.Contract.NamedKeys.GetValidatorStake(addr1))
+	require.Equal(t, "1000000000000000000", sv.Contract.NamedKeys.GetDelegateFromDelegator(addr2)[hexString(addr1)])
+	require.Equal(t, "400000000000000000", sv.Contract.NamedKeys.GetVotingDappFromUser(addr2)[hexString(dapp)])
+
+	stake, errMessage := grpc.QueryStake(engine, stateHash, addr2, protocolVersion)
+	require.Equal(t, "", errMessage)
+	require.Equal(t, "1000000000000000000", stake)
+	voted, errMessage := grpc.QueryVoted(engine, stateHash, dapp, protocolVersion)
+	require.Equal(t, "", errMessage)
+	require.Equal(t, "400000000000000000", voted)
+
+	// the delegation is voted already
+	_, result = executeAndCommit(t, engine, stateHash,
+		proxyDeploy(t, addr2, types.BASIC_FEE, strArg(types.VoteMethodName), voteArg, u512Arg("700000000000000000")))
+	require.NotNil(t, result.GetExecutionResult().GetError().GetExecError())
+}
+
+func TestSlash(t *testing.T) {
+	engine := NewExecutionEngine()
+	stateHash := genesis(t, engine)
+
+	stateHash, _ = executeAndCommit(t, engine, stateHash,
+		proxyDeploy(t, addr1, types.BASIC_FEE, strArg(types.TransferMethodName), bytesArg(addr2), u512Arg("2000000000000000000")))
+	stateHash, _ = executeAndCommit(t, engine, stateHash,
+		proxyDeploy(t, addr2, types.BASIC_FEE, strArg(types.DelegateMethodName), bytesArg(addr1), u512Arg("1000000000000000000")))
+
+	// the validator and the delegator are slashed in proportion to their delegations
+	res, err := engine.Slash(context.Background(), &ipc.SlashRequest{
+		ParentStateHash: stateHash,
+		Slashes: []*ipc.SlashRequest_ValidatorSlash{
+			{ValidatorId: addr1, Value: &state.BigInt{Value: "400000000000000000", BitWidth: 512}},
+		},
+		ProtocolVersion: protocolVersion,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, res.GetSuccess())
+	stateHash = res.GetSuccess().GetPoststateHash()
+
+	queryRes, errMessage := grpc.Query(engine, stateHash, grpc.STR_ADDRESS, grpc.SYSTEM_ACCOUNT, []string{types.PosContractName}, protocolVersion)
+	require.Equal(t, "", errMessage)
+	var sv storedvalue.StoredValue
+	sv, err, _ = sv.FromBytes(queryRes)
+	require.Nil(t, err)
+	require.Equal(t, "1600000000000000000", sv.Contract.NamedKeys.GetValidatorStake(addr1))
+	require.Equal(t, "800000000000000000", sv.Contract.NamedKeys.GetDelegateFromDelegator(addr2)[hexString(addr1)])
+
+	// the slash is capped at the stake of the validator
+	res, err = engine.Slash(context.Background(), &ipc.SlashRequest{
+		ParentStateHash: stateHash,
+		Slashes: []*ipc.SlashRequest_ValidatorSlash{
+			{ValidatorId: addr1, Value: &state.BigInt{Value: "9000000000000000000", BitWidth: 512}},
+		},
+		ProtocolVersion: protocolVersion,
+	})
+	require.Nil(t, err)
+	require.Empty(t, res.GetSuccess().GetBondedValidators())
+}
+
+func hexString(src []byte) string {
+	return util.EncodeToHexString(src)
+}
+
+func TestGenesisInvalidStateInfo(t *testing.T) {
+	engine := NewExecutionEngine()
+	res, err := grpc.RunGenesis(engine, &ipc.ChainSpec_GenesisConfig{
+		ProtocolVersion: protocolVersion,
+		StateInfos:      []string{"1000"},
+	})
+	require.Nil(t, err)
+	require.NotNil(t, res.GetFailedDeploy())
+}
+
+func TestApplyNegativeValue(t *testing.T) {
+	w := world{balanceKey(addr1): big.NewInt(10)}
+	err := w.apply([]op{
+		{key: balanceKey(addr2), delta: big.NewInt(20)},
+		{key: balanceKey(addr1), delta: big.NewInt(-20)},
+	})
+	require.NotNil(t, err)
+
+	// the world is untouched
+	require.Equal(t, world{balanceKey(addr1): big.NewInt(10)}, w)
+}
@@ -0,0 +1,590 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/storedvalue"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+)
+
+const (
+	// DeployCost is the gas every deploy consumes in the mock engine, regardless of what it does
+	DeployCost = 100000000
+)
+
+//______________________________________________________________________
+// ABI decoding of deploy args
+
+func decodeArgs(abi []byte) (args []storedvalue.CLValue, err error) {
+	// CLValue.FromBytes does not check bounds
+	defer func() {
+		if r := recover(); r != nil {
+			args, err = nil, fmt.Errorf("invalid args: %v", r)
+		}
+	}()
+
+	if len(abi) < storedvalue.SIZE_LENGTH {
+		return nil, fmt.Errorf("invalid args: too short")
+	}
+	count := int(binary.LittleEndian.Uint32(abi))
+	pos := storedvalue.SIZE_LENGTH
+	for i := 0; i < count; i++ {
+		var clValue storedvalue.CLValue
+		clValue, err, length := clValue.FromBytes(abi[pos:])
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, clValue)
+		pos += length
+	}
+	return args, nil
+}
+
+func argTag(args []storedvalue.CLValue, idx int) (storedvalue.CL_TYPE_TAG, error) {
+	if idx >= len(args) {
+		return 0, fmt.Errorf("missing argument %d", idx)
+	}
+	return args[idx].Tags[storedvalue.TAG_INDEX], nil
+}
+
+func argString(args []storedvalue.CLValue, idx int) (string, error) {
+	tag, err := argTag(args, idx)
+	if err != nil {
+		return "", err
+	}
+	value := args[idx].Bytes
+	if tag != storedvalue.TAG_STRING || len(value) < storedvalue.SIZE_LENGTH {
+		return "", fmt.Errorf("argument %d is not a string", idx)
+	}
+	return string(value[storedvalue.SIZE_LENGTH:]), nil
+}
+
+func argBytes(args []storedvalue.CLValue, idx int) ([]byte, error) {
+	tag, err := argTag(args, idx)
+	if err != nil {
+		return nil, err
+	}
+	if tag != storedvalue.TAG_FIXED_LIST && tag != storedvalue.TAG_LIST && tag != storedvalue.TAG_KEY {
+		return nil, fmt.Errorf("argument %d is not bytes", idx)
+	}
+	return args[idx].Bytes, nil
+}
+
+func parseU512(value []byte) (*big.Int, bool) {
+	if len(value) < 1 || len(value) != int(value[0])+1 {
+		return nil, false
+	}
+	magnitude := make([]byte, len(value)-1)
+	for i := range magnitude {
+		magnitude[i] = value[len(value)-1-i]
+	}
+	return new(big.Int).SetBytes(magnitude), true
+}
+
+func argU512(args []storedvalue.CLValue, idx int) (*big.Int, error) {
+	tag, err := argTag(args, idx)
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := parseU512(args[idx].Bytes)
+	if tag != storedvalue.TAG_U512 || !ok {
+		return nil, fmt.Errorf("argument %d is not an U512", idx)
+	}
+	return amount, nil
+}
+
+// argList returns the serialized entries of a list argument of the inner type
+func argList(args []storedvalue.CLValue, idx int, inner storedvalue.CL_TYPE_TAG) (count int, entries []byte, err error) {
+	tag, err := argTag(args, idx)
+	if err != nil {
+		return 0, nil, err
+	}
+	value := args[idx].Bytes
+	if tag != storedvalue.TAG_LIST || args[idx].Tags[1] != inner || len(value) < storedvalue.SIZE_LENGTH {
+		return 0, nil, fmt.Errorf("argument %d is not a list", idx)
+	}
+	return int(binary.LittleEndian.Uint32(value)), value[storedvalue.SIZE_LENGTH:], nil
+}
+
+// argAccountList returns the addresses of a list of account keys
+func argAccountList(args []storedvalue.CLValue, idx int) ([][]byte, error) {
+	count, entries, err := argList(args, idx, storedvalue.TAG_KEY)
+	if err != nil {
+		return nil, err
+	}
+	const keyLength = 1 + 32
+	if len(entries) != count*keyLength {
+		return nil, fmt.Errorf("argument %d is not a list of account keys", idx)
+	}
+	addresses := [][]byte{}
+	for i := 0; i < count; i++ {
+		entry := entries[i*keyLength : (i+1)*keyLength]
+		if entry[0] != 0 {
+			return nil, fmt.Errorf("argument %d is not a list of account keys", idx)
+		}
+		addresses = append(addresses, entry[1:])
+	}
+	return addresses, nil
+}
+
+// argU512List returns the values of a list of U512
+func argU512List(args []storedvalue.CLValue, idx int) ([]*big.Int, error) {
+	count, entries, err := argList(args, idx, storedvalue.TAG_U512)
+	if err != nil {
+		return nil, err
+	}
+	values := []*big.Int{}
+	for i := 0; i < count; i++ {
+		if len(entries) < 1 || len(entries) < int(entries[0])+1 {
+			return nil, fmt.Errorf("argument %d is not a list of U512", idx)
+		}
+		value, _ := parseU512(entries[:int(entries[0])+1])
+		values = append(values, value)
+		entries = entries[int(entries[0])+1:]
+	}
+	return values, nil
+}
+
+func argU8(args []storedvalue.CLValue, idx int) (*big.Int, error) {
+	tag, err := argTag(args, idx)
+	if err != nil {
+		return nil, err
+	}
+	if tag != storedvalue.TAG_U8 || len(args[idx].Bytes) != 1 {
+		return nil, fmt.Errorf("argument %d is not an U8", idx)
+	}
+	return big.NewInt(int64(args[idx].Bytes[0])), nil
+}
+
+// argOptionU512 returns nil for None
+func argOptionU512(args []storedvalue.CLValue, idx int) (*big.Int, error) {
+	tag, err := argTag(args, idx)
+	if err != nil {
+		return nil, err
+	}
+	value := args[idx].Bytes
+	switch {
+	case tag == storedvalue.TAG_U512:
+		return argU512(args, idx)
+	case tag != storedvalue.TAG_OPTION || len(value) < 1:
+		return nil, fmt.Errorf("argument %d is not an Option<U512>", idx)
+	case value[0] == 0:
+		return nil, nil
+	}
+	amount, ok := parseU512(value[1:])
+	if !ok {
+		return nil, fmt.Errorf("argument %d is not an Option<U512>", idx)
+	}
+	return amount, nil
+}
+
+//______________________________________________________________________
+// Deploy execution
+
+// payment returns the payer and the amount of the payment.
+// The payer of a delegated payment must authorize the deploy.
+func payment(deploy *ipc.DeployItem) (payer []byte, amount *big.Int, err error) {
+	stored := deploy.GetPayment().GetStoredContractHash()
+	if stored == nil || !bytes.Equal(stored.GetHash(), proxyContractHash) {
+		return nil, nil, fmt.Errorf("payment must be the %s contract", types.ProxyContractName)
+	}
+
+	args, err := decodeArgs(stored.GetArgs())
+	if err != nil {
+		return nil, nil, err
+	}
+	method, err := argString(args, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch method {
+	case types.PaymentMethodName:
+		amount, err = argU512(args, 1)
+		return deploy.GetAddress(), amount, err
+	case types.DelegatedPaymentMethodName:
+		payer, err = argBytes(args, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, key := range deploy.GetAuthorizationKeys() {
+			if bytes.Equal(key, payer) {
+				amount, err = argU512(args, 2)
+				return payer, amount, err
+			}
+		}
+		return nil, nil, fmt.Errorf("Authorization failure: the payer has not authorized the deploy")
+	}
+	return nil, nil, fmt.Errorf("unknown payment method: %s", method)
+}
+
+// executeDeploy runs a single deploy on the given world, and applies its effects to the world.
+func (w world) executeDeploy(deploy *ipc.DeployItem) *ipc.DeployResult {
+	from := deploy.GetAddress()
+	if !w.hasAccount(from) {
+		return preconditionFailure("Authorization failure: not authorized.")
+	}
+	weight := w.authorizedWeight(from, deploy.GetAuthorizationKeys())
+	if weight.Cmp(w.threshold(from, deploymentAction)) < 0 {
+		return preconditionFailure("Deployment authorization failure")
+	}
+
+	payer, fee, err := payment(deploy)
+	if err != nil {
+		return preconditionFailure(err.Error())
+	}
+	if !w.hasAccount(payer) {
+		return preconditionFailure("Authorization failure: not authorized.")
+	}
+
+	cost := big.NewInt(DeployCost)
+	charge := new(big.Int).Mul(cost, new(big.Int).SetUint64(deploy.GetGasPrice()))
+	if w.get(balanceKey(payer)).Cmp(fee) < 0 {
+		return preconditionFailure("Insufficient payment")
+	}
+
+	// the payment is taken even if the deploy fails
+	if fee.Cmp(charge) < 0 {
+		ops := []op{{key: balanceKey(payer), delta: new(big.Int).Neg(fee)}}
+		w.apply(ops)
+		return executionResult(ops, cost, &ipc.DeployError{Value: &ipc.DeployError_GasError{GasError: &ipc.DeployError_OutOfGasError{}}})
+	}
+	chargeOps := []op{{key: balanceKey(payer), delta: new(big.Int).Neg(charge)}}
+	w.apply(chargeOps)
+
+	ops, err := w.session(from, weight, deploy.GetDeployHash(), deploy.GetSession())
+	if err == nil {
+		err = w.apply(ops)
+	}
+	if err != nil {
+		return executionResult(chargeOps, cost, &ipc.DeployError{Value: &ipc.DeployError_ExecError{ExecError: &ipc.DeployError_ExecutionError{Message: err.Error()}}})
+	}
+
+	return executionResult(append(chargeOps, ops...), cost, nil)
+}
+
+// session interprets the calls of the proxy contract with the weight of the keys authorizing the deploy.
+// Wasm sessions are not interpreted, but stored as a contract at the hash of the deploy.
+func (w world) session(from []byte, weight *big.Int, deployHash []byte, session *ipc.DeployPayload) ([]op, error) {
+	if session.GetDeployCode() != nil {
+		return []op{{key: contractKey(deployHash), delta: big.NewInt(1)}}, nil
+	}
+
+	stored := session.GetStoredContractHash()
+	if stored == nil || !bytes.Equal(stored.GetHash(), proxyContractHash) {
+		return []op{}, nil
+	}
+
+	args, err := decodeArgs(stored.GetArgs())
+	if err != nil {
+		return nil, err
+	}
+	method, err := argString(args, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method {
+	case types.TransferMethodName:
+		return w.transfer(from, args)
+	case types.MultiTransferMethodName:
+		return w.multiTransfer(from, args)
+	case types.PaymentMethodName:
+		return w.pay(from, args)
+	case types.BondMethodName:
+		return w.bond(from, args)
+	case types.UnbondMethodName:
+		return w.unbond(from, args)
+	case types.DelegateMethodName:
+		return w.delegate(from, args)
+	case types.UndelegateMethodName:
+		return w.undelegate(from, args)
+	case types.RedelegateMethodName:
+		return w.redelegate(from, args)
+	case types.VoteMethodName:
+		return w.vote(from, args)
+	case types.UnvoteMethodName:
+		return w.unvote(from, args)
+	case types.ClaimRewardMethodName:
+		return w.claim(from, rewardKey(from))
+	case types.ClaimCommissionMethodName:
+		return w.claim(from, commissionKey(from))
+	case types.AddAssociatedKeyMethodName, types.RemoveAssociatedKeyMethodName, types.SetActionThresholdsMethodName:
+		if weight.Cmp(w.threshold(from, keyManagementAction)) < 0 {
+			return nil, fmt.Errorf("Key management authorization failure")
+		}
+		return w.manageKeys(from, method, args)
+	default:
+		return nil, fmt.Errorf("unknown method: %s", method)
+	}
+}
+
+func (w world) move(fromKey, toKey string, amount *big.Int) ([]op, error) {
+	if w.get(fromKey).Cmp(amount) < 0 {
+		return nil, fmt.Errorf("insufficient amount: %s < %s", w.get(fromKey).String(), amount.String())
+	}
+	return []op{
+		{key: fromKey, delta: new(big.Int).Neg(amount)},
+		{key: toKey, delta: new(big.Int).Set(amount)},
+	}, nil
+}
+
+// moveOption moves the whole value of fromKey if amount is None
+func (w world) moveOption(fromKey, toKey string, amount *big.Int) ([]op, error) {
+	if amount == nil {
+		amount = w.get(fromKey)
+	}
+	if amount.Sign() == 0 {
+		return nil, fmt.Errorf("nothing to move")
+	}
+	return w.move(fromKey, toKey, amount)
+}
+
+func (w world) bonded(validator []byte) bool {
+	return w.get(delegationKey(validator, validator)).Sign() > 0
+}
+
+func (w world) transfer(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	to, err := argBytes(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := argU512(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return w.move(balanceKey(from), balanceKey(to), amount)
+}
+
+// multiTransfer moves the amounts to the recipients, only if the balance covers all of them
+func (w world) multiTransfer(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	recipients, err := argAccountList(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	amounts, err := argU512List(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	if len(recipients) != len(amounts) {
+		return nil, fmt.Errorf("%d recipients, but %d amounts", len(recipients), len(amounts))
+	}
+
+	total := new(big.Int)
+	ops := []op{}
+	for i, recipient := range recipients {
+		total.Add(total, amounts[i])
+		ops = append(ops, op{key: balanceKey(recipient), delta: amounts[i]})
+	}
+	if w.get(balanceKey(from)).Cmp(total) < 0 {
+		return nil, fmt.Errorf("insufficient amount: %s < %s", w.get(balanceKey(from)).String(), total.String())
+	}
+	return append([]op{{key: balanceKey(from), delta: new(big.Int).Neg(total)}}, ops...), nil
+}
+
+// manageKeys adds or removes an associated key, or sets the action thresholds of the account.
+// The total weight of the keys must reach the thresholds, and the deployment threshold can't exceed the key management threshold.
+func (w world) manageKeys(from []byte, method string, args []storedvalue.CLValue) ([]op, error) {
+	associatedKeys, stored := w.associatedKeys(from)
+	ops := []op{}
+	// the account itself is stored as a key before the keys change
+	if !stored {
+		ops = append(ops, op{key: associatedKeyKey(from, from), delta: big.NewInt(1)})
+	}
+
+	keyManagement := w.threshold(from, keyManagementAction)
+	total := new(big.Int)
+	for _, weight := range associatedKeys {
+		total.Add(total, weight)
+	}
+
+	switch method {
+	case types.AddAssociatedKeyMethodName:
+		key, err := argBytes(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		weight, err := argU8(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := associatedKeys[hex.EncodeToString(key)]; ok {
+			return nil, fmt.Errorf("associated key %s already exists", hex.EncodeToString(key))
+		}
+		return append(ops, op{key: associatedKeyKey(from, key), delta: weight}), nil
+
+	case types.RemoveAssociatedKeyMethodName:
+		key, err := argBytes(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		weight, ok := associatedKeys[hex.EncodeToString(key)]
+		if !ok {
+			return nil, fmt.Errorf("associated key %s not found", hex.EncodeToString(key))
+		}
+		if total.Sub(total, weight).Cmp(keyManagement) < 0 {
+			return nil, fmt.Errorf("the remaining weight %s is below the key management threshold %s", total.String(), keyManagement.String())
+		}
+		return append(ops, op{key: associatedKeyKey(from, key), delta: new(big.Int).Neg(weight)}), nil
+
+	case types.SetActionThresholdsMethodName:
+		newDeployment, err := argU8(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		newKeyManagement, err := argU8(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		if newDeployment.Sign() == 0 || newDeployment.Cmp(newKeyManagement) > 0 {
+			return nil, fmt.Errorf("invalid thresholds %s, %s", newDeployment.String(), newKeyManagement.String())
+		}
+		if newKeyManagement.Cmp(total) > 0 {
+			return nil, fmt.Errorf("the key management threshold %s exceeds the total weight %s", newKeyManagement.String(), total.String())
+		}
+		// thresholds not set yet are 1, but have no entries
+		return []op{
+			{key: thresholdKey(from, deploymentAction), delta: new(big.Int).Sub(newDeployment, w.get(thresholdKey(from, deploymentAction)))},
+			{key: thresholdKey(from, keyManagementAction), delta: new(big.Int).Sub(newKeyManagement, w.get(thresholdKey(from, keyManagementAction)))},
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown method: %s", method)
+}
+
+// pay burns the amount, as the standard payment in the session does
+func (w world) pay(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	amount, err := argU512(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	if w.get(balanceKey(from)).Cmp(amount) < 0 {
+		return nil, fmt.Errorf("insufficient balance to pay %s", amount.String())
+	}
+	return []op{{key: balanceKey(from), delta: new(big.Int).Neg(amount)}}, nil
+}
+
+func (w world) bond(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	amount, err := argU512(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	return w.move(balanceKey(from), delegationKey(from, from), amount)
+}
+
+func (w world) unbond(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	amount, err := argOptionU512(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	return w.moveOption(delegationKey(from, from), balanceKey(from), amount)
+}
+
+func (w world) delegate(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	validator, err := argBytes(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := argU512(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	if !w.bonded(validator) {
+		return nil, fmt.Errorf("validator is not bonded")
+	}
+	return w.move(balanceKey(from), delegationKey(from, validator), amount)
+}
+
+func (w world) undelegate(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	validator, err := argBytes(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := argOptionU512(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return w.moveOption(delegationKey(from, validator), balanceKey(from), amount)
+}
+
+func (w world) redelegate(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	src, err := argBytes(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := argBytes(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := argOptionU512(args, 3)
+	if err != nil {
+		return nil, err
+	}
+	if !w.bonded(dest) {
+		return nil, fmt.Errorf("validator is not bonded")
+	}
+	return w.moveOption(delegationKey(from, src), delegationKey(from, dest), amount)
+}
+
+// vote uses the delegated amount which is not voted yet
+func (w world) vote(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	dapp, err := argBytes(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := argU512(args, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	votable := w.sumBy(delegationPrefix, 0, from)
+	votable.Sub(votable, w.sumBy(votePrefix, 0, from))
+	if votable.Cmp(amount) < 0 {
+		return nil, fmt.Errorf("insufficient amount to vote: %s < %s", votable.String(), amount.String())
+	}
+	return []op{{key: voteKey(from, dapp), delta: amount}}, nil
+}
+
+func (w world) unvote(from []byte, args []storedvalue.CLValue) ([]op, error) {
+	dapp, err := argBytes(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := argOptionU512(args, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	voted := w.get(voteKey(from, dapp))
+	if amount == nil {
+		amount = voted
+	}
+	if amount.Sign() == 0 || voted.Cmp(amount) < 0 {
+		return nil, fmt.Errorf("insufficient voted amount: %s", voted.String())
+	}
+	return []op{{key: voteKey(from, dapp), delta: new(big.Int).Neg(amount)}}, nil
+}
+
+func (w world) claim(from []byte, key string) ([]op, error) {
+	return w.moveOption(key, balanceKey(from), nil)
+}
+
+//______________________________________________________________________
+
+func preconditionFailure(msg string) *ipc.DeployResult {
+	return &ipc.DeployResult{Value: &ipc.DeployResult_PreconditionFailure_{
+		PreconditionFailure: &ipc.DeployResult_PreconditionFailure{Message: msg}}}
+}
+
+func executionResult(ops []op, cost *big.Int, deployError *ipc.DeployError) *ipc.DeployResult {
+	return &ipc.DeployResult{Value: &ipc.DeployResult_ExecutionResult_{
+		ExecutionResult: &ipc.DeployResult_ExecutionResult{
+			Effects: &ipc.ExecutionEffect{TransformMap: opsToEffects(ops)},
+			Error:   deployError,
+			Cost:    &state.BigInt{Value: cost.String(), BitWidth: 512},
+		}}}
+}
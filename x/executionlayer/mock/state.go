@@ -0,0 +1,349 @@
+package mock
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc/transforms"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
+)
+
+// Prefixes of the world state entries.
+// Every entry of the world state is a non-negative amount of motes,
+// except the weights of the associated keys and the action thresholds of accounts.
+const (
+	balancePrefix       = "b"
+	delegationPrefix    = "d"
+	votePrefix          = "a"
+	rewardPrefix        = "r"
+	commissionPrefix    = "c"
+	contractPrefix      = "s"
+	associatedKeyPrefix = "k"
+	thresholdPrefix     = "t"
+
+	keySeparator = "/"
+
+	deploymentAction    = "deployment"
+	keyManagementAction = "key_management"
+)
+
+// op is a signed delta applied to a single entry of the world state
+type op struct {
+	key   string
+	delta *big.Int
+}
+
+// world is the whole state of the mock engine at a single state hash
+type world map[string]*big.Int
+
+func balanceKey(addr []byte) string {
+	return strings.Join([]string{balancePrefix, hex.EncodeToString(addr)}, keySeparator)
+}
+
+func delegationKey(delegator, validator []byte) string {
+	return strings.Join([]string{delegationPrefix, hex.EncodeToString(delegator), hex.EncodeToString(validator)}, keySeparator)
+}
+
+func voteKey(user, dapp []byte) string {
+	return strings.Join([]string{votePrefix, hex.EncodeToString(user), hex.EncodeToString(dapp)}, keySeparator)
+}
+
+func rewardKey(addr []byte) string {
+	return strings.Join([]string{rewardPrefix, hex.EncodeToString(addr)}, keySeparator)
+}
+
+func commissionKey(addr []byte) string {
+	return strings.Join([]string{commissionPrefix, hex.EncodeToString(addr)}, keySeparator)
+}
+
+// contractKey marks a contract stored at the hash
+func contractKey(hash []byte) string {
+	return strings.Join([]string{contractPrefix, hex.EncodeToString(hash)}, keySeparator)
+}
+
+// associatedKeyKey is the weight of the associated key of the account
+func associatedKeyKey(account, key []byte) string {
+	return strings.Join([]string{associatedKeyPrefix, hex.EncodeToString(account), hex.EncodeToString(key)}, keySeparator)
+}
+
+// thresholdKey is the threshold of the action of the account
+func thresholdKey(account []byte, action string) string {
+	return strings.Join([]string{thresholdPrefix, hex.EncodeToString(account), action}, keySeparator)
+}
+
+func (w world) copy() world {
+	res := make(world, len(w))
+	for k, v := range w {
+		res[k] = new(big.Int).Set(v)
+	}
+	return res
+}
+
+func (w world) get(key string) *big.Int {
+	if v, ok := w[key]; ok {
+		return new(big.Int).Set(v)
+	}
+	return new(big.Int)
+}
+
+func (w world) hasAccount(addr []byte) bool {
+	_, ok := w[balanceKey(addr)]
+	return ok
+}
+
+// apply adds every delta to the world. Balances are kept even when they hit zero,
+// because they mark the existence of the account.
+// The world is left untouched if any value would go negative.
+func (w world) apply(ops []op) error {
+	values := map[string]*big.Int{}
+	for _, o := range ops {
+		v, ok := values[o.key]
+		if !ok {
+			v = w.get(o.key)
+			values[o.key] = v
+		}
+		v.Add(v, o.delta)
+		if v.Sign() < 0 {
+			return fmt.Errorf("negative value of %s", o.key)
+		}
+	}
+
+	for k, v := range values {
+		if v.Sign() == 0 && !strings.HasPrefix(k, balancePrefix+keySeparator) {
+			delete(w, k)
+			continue
+		}
+		w[k] = v
+	}
+	return nil
+}
+
+// hash returns the deterministic state hash of the world
+func (w world) hash() []byte {
+	keys := make([]string, 0, len(w))
+	for k := range w {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteString("=")
+		sb.WriteString(w[k].String())
+		sb.WriteString(";")
+	}
+	return util.Blake2b256([]byte(sb.String()))
+}
+
+// entries returns the split keys and values of every entry with the given prefix
+func (w world) entries(prefix string) (keys [][]string, values []*big.Int) {
+	all := make([]string, 0, len(w))
+	for k := range w {
+		if strings.HasPrefix(k, prefix+keySeparator) {
+			all = append(all, k)
+		}
+	}
+	sort.Strings(all)
+
+	for _, k := range all {
+		keys = append(keys, strings.Split(k, keySeparator)[1:])
+		values = append(values, w.get(k))
+	}
+	return keys, values
+}
+
+// associatedKeys returns the weights of the associated keys of the account in hex, and whether they are stored.
+// An account has only itself with weight 1 until it changes the keys.
+func (w world) associatedKeys(account []byte) (map[string]*big.Int, bool) {
+	res := map[string]*big.Int{}
+	accountHex := hex.EncodeToString(account)
+	keys, values := w.entries(associatedKeyPrefix)
+	for i, k := range keys {
+		if k[0] == accountHex {
+			res[k[1]] = values[i]
+		}
+	}
+	if len(res) == 0 {
+		res[accountHex] = big.NewInt(1)
+		return res, false
+	}
+	return res, true
+}
+
+// threshold returns the threshold of the action of the account, which is 1 if not set
+func (w world) threshold(account []byte, action string) *big.Int {
+	if v, ok := w[thresholdKey(account, action)]; ok {
+		return new(big.Int).Set(v)
+	}
+	return big.NewInt(1)
+}
+
+// authorizedWeight returns the total weight of the authorization keys associated with the account
+func (w world) authorizedWeight(account []byte, authorizationKeys [][]byte) *big.Int {
+	associatedKeys, _ := w.associatedKeys(account)
+	weight := new(big.Int)
+	seen := map[string]bool{}
+	for _, key := range authorizationKeys {
+		keyHex := hex.EncodeToString(key)
+		if keyWeight, ok := associatedKeys[keyHex]; ok && !seen[keyHex] {
+			weight.Add(weight, keyWeight)
+			seen[keyHex] = true
+		}
+	}
+	return weight
+}
+
+// stakes returns the sum of delegations per validator in hex
+func (w world) stakes() map[string]*big.Int {
+	res := map[string]*big.Int{}
+	keys, values := w.entries(delegationPrefix)
+	for i, k := range keys {
+		validator := k[1]
+		if _, ok := res[validator]; !ok {
+			res[validator] = new(big.Int)
+		}
+		res[validator].Add(res[validator], values[i])
+	}
+	return res
+}
+
+// slash takes value from the delegations to the validator in proportion to them.
+// The value is capped at the stake of the validator, and the remainder of the division is taken in key order.
+func (w world) slash(validator []byte, value *big.Int) []op {
+	validatorHex := hex.EncodeToString(validator)
+	stake := w.stakes()[validatorHex]
+	if stake == nil || stake.Sign() == 0 {
+		return []op{}
+	}
+	if stake.Cmp(value) < 0 {
+		value = stake
+	}
+
+	keys, values := w.entries(delegationPrefix)
+	ops := []op{}
+	cuts := []*big.Int{}
+	rest := new(big.Int).Set(value)
+	for i, k := range keys {
+		if k[1] != validatorHex {
+			continue
+		}
+		cut := new(big.Int).Mul(values[i], value)
+		cut.Quo(cut, stake)
+		rest.Sub(rest, cut)
+		ops = append(ops, op{key: strings.Join(append([]string{delegationPrefix}, k...), keySeparator)})
+		cuts = append(cuts, cut)
+	}
+	for i := range ops {
+		if left := new(big.Int).Sub(w.get(ops[i].key), cuts[i]); rest.Sign() > 0 && left.Sign() > 0 {
+			if left.Cmp(rest) > 0 {
+				left = rest
+			}
+			cuts[i].Add(cuts[i], left)
+			rest.Sub(rest, left)
+		}
+		ops[i].delta = new(big.Int).Neg(cuts[i])
+	}
+	return ops
+}
+
+// bonds returns the bonded validators sorted by address
+func (w world) bonds() []*ipc.Bond {
+	stakes := w.stakes()
+	validators := make([]string, 0, len(stakes))
+	for validator := range stakes {
+		validators = append(validators, validator)
+	}
+	sort.Strings(validators)
+
+	bonds := []*ipc.Bond{}
+	for _, validator := range validators {
+		pubKey, _ := hex.DecodeString(validator)
+		bonds = append(bonds, &ipc.Bond{
+			ValidatorPublicKey: pubKey,
+			Stake:              &state.BigInt{Value: stakes[validator].String(), BitWidth: 512},
+		})
+	}
+	return bonds
+}
+
+// addresses returns every address known to the world, used to resolve local keys
+func (w world) addresses() [][]byte {
+	seen := map[string]bool{}
+	res := [][]byte{}
+	add := func(h string) {
+		if seen[h] {
+			return
+		}
+		seen[h] = true
+		if b, err := hex.DecodeString(h); err == nil {
+			res = append(res, b)
+		}
+	}
+
+	for k := range w {
+		for _, part := range strings.Split(k, keySeparator)[1:] {
+			add(part)
+		}
+	}
+	return res
+}
+
+// sumBy sums the entries with the given prefix whose position-th element equals to addr
+func (w world) sumBy(prefix string, position int, addr []byte) *big.Int {
+	res := new(big.Int)
+	target := hex.EncodeToString(addr)
+	keys, values := w.entries(prefix)
+	for i, k := range keys {
+		if k[position] == target {
+			res.Add(res, values[i])
+		}
+	}
+	return res
+}
+
+// opsToEffects carries the world deltas as transforms, so that Commit can replay them.
+// A stored contract is written as a contract at its hash, as the engine does.
+func opsToEffects(ops []op) []*transforms.TransformEntry {
+	effects := []*transforms.TransformEntry{}
+	for _, o := range ops {
+		if strings.HasPrefix(o.key, contractPrefix+keySeparator) {
+			hash, _ := hex.DecodeString(strings.TrimPrefix(o.key, contractPrefix+keySeparator))
+			effects = append(effects, &transforms.TransformEntry{
+				Key: &state.Key{Value: &state.Key_Hash_{Hash: &state.Key_Hash{Hash: hash}}},
+				Transform: &transforms.Transform{TransformInstance: &transforms.Transform_Write{
+					Write: &transforms.TransformWrite{Value: &state.StoredValue{Variants: &state.StoredValue_Contract{Contract: &state.Contract{}}}}}},
+			})
+			continue
+		}
+		effects = append(effects, &transforms.TransformEntry{
+			Key: &state.Key{Value: &state.Key_Local_{Local: &state.Key_Local{Hash: []byte(o.key)}}},
+			Transform: &transforms.Transform{TransformInstance: &transforms.Transform_AddBigInt{
+				AddBigInt: &transforms.TransformAddBigInt{Value: &state.BigInt{Value: o.delta.String(), BitWidth: 512}}}},
+		})
+	}
+	return effects
+}
+
+func effectsToOps(effects []*transforms.TransformEntry) ([]op, error) {
+	ops := []op{}
+	for _, effect := range effects {
+		if effect.GetTransform().GetWrite().GetValue().GetContract() != nil && effect.GetKey().GetHash() != nil {
+			ops = append(ops, op{key: contractKey(effect.GetKey().GetHash().GetHash()), delta: big.NewInt(1)})
+			continue
+		}
+		key := effect.GetKey().GetLocal().GetHash()
+		value := effect.GetTransform().GetAddBigInt().GetValue().GetValue()
+		delta, ok := new(big.Int).SetString(value, 10)
+		if key == nil || !ok {
+			return nil, fmt.Errorf("unknown transform: %s", effect.String())
+		}
+		ops = append(ops, op{key: string(key), delta: delta})
+	}
+	return ops, nil
+}
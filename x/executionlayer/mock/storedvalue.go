@@ -0,0 +1,295 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/grpc"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/storedvalue"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+)
+
+var (
+	mintURef          = util.Blake2b256([]byte(types.MintContractName))
+	posURef           = util.Blake2b256([]byte(types.PosContractName))
+	proxyContractHash = util.Blake2b256([]byte(types.ProxyContractName))
+
+	// named keys of the PoS contract point nowhere, only their names carry the information
+	emptyHash = make([]byte, storedvalue.ADDRESS_LENGTH)
+)
+
+func purseAddress(addr []byte) []byte {
+	return util.Blake2b256(append([]byte("purse"), addr...))
+}
+
+func balanceURef(addr []byte) []byte {
+	return util.Blake2b256(append([]byte("balance"), addr...))
+}
+
+func localKey(seed []byte, keyBytes []byte) []byte {
+	return util.MakeLocalKey(append([]byte{}, seed...), keyBytes)
+}
+
+func posLocalKey(prefix byte, addr []byte) []byte {
+	localBytes := append([]byte{prefix}, addr...)
+	res := make([]byte, storedvalue.SIZE_LENGTH)
+	binary.LittleEndian.PutUint32(res, uint32(len(localBytes)))
+	return localKey(posURef, append(res, localBytes...))
+}
+
+//______________________________________________________________________
+// Serializers. They follow the FromBytes of the storedvalue package.
+
+func sizeBytes(size int) []byte {
+	res := make([]byte, storedvalue.SIZE_LENGTH)
+	binary.LittleEndian.PutUint32(res, uint32(size))
+	return res
+}
+
+func urefBytes(address []byte) []byte {
+	return append(append([]byte{}, address...), byte(state.Key_URef_READ_ADD_WRITE))
+}
+
+func namedKeyBytes(name string, keyID storedvalue.KEY_ID, keyData []byte) []byte {
+	res := sizeBytes(len(name))
+	res = append(res, []byte(name)...)
+	res = append(res, byte(keyID))
+	return append(res, keyData...)
+}
+
+func u512Bytes(value *big.Int) []byte {
+	magnitude := value.Bytes()
+	res := []byte{byte(len(magnitude))}
+	for i := len(magnitude) - 1; i >= 0; i-- {
+		res = append(res, magnitude[i])
+	}
+	return res
+}
+
+func clValueBytes(value []byte, tag storedvalue.CL_TYPE_TAG) []byte {
+	res := []byte{byte(storedvalue.TYPE_CL_VALUE)}
+	res = append(res, sizeBytes(len(value))...)
+	res = append(res, value...)
+	return append(res, byte(tag))
+}
+
+// accountBytes returns the account with the weights of the associated keys in hex, and the action thresholds
+func accountBytes(addr []byte, namedKeys [][]byte, purse []byte, associatedKeys map[string]*big.Int, deployment, keyManagement *big.Int) []byte {
+	res := []byte{byte(storedvalue.TYPE_ACCOUNT)}
+	res = append(res, addr...)
+	res = append(res, sizeBytes(len(namedKeys))...)
+	for _, namedKey := range namedKeys {
+		res = append(res, namedKey...)
+	}
+	res = append(res, urefBytes(purse)...)
+
+	keys := make([]string, 0, len(associatedKeys))
+	for key := range associatedKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	res = append(res, sizeBytes(len(keys))...)
+	for _, key := range keys {
+		keyBytes, _ := hex.DecodeString(key)
+		res = append(res, keyBytes...)
+		res = append(res, byte(associatedKeys[key].Uint64()))
+	}
+
+	return append(res, byte(deployment.Uint64()), byte(keyManagement.Uint64()))
+}
+
+func contractBytes(namedKeys [][]byte, protocolVersion *state.ProtocolVersion) []byte {
+	res := []byte{byte(storedvalue.TYPE_CONTRACT)}
+	res = append(res, sizeBytes(0)...)
+	res = append(res, sizeBytes(len(namedKeys))...)
+	for _, namedKey := range namedKeys {
+		res = append(res, namedKey...)
+	}
+
+	version := make([]byte, storedvalue.PROTOCOL_VERSION_LENGTH)
+	binary.LittleEndian.PutUint32(version[0:], protocolVersion.GetMajor())
+	binary.LittleEndian.PutUint32(version[4:], protocolVersion.GetMinor())
+	binary.LittleEndian.PutUint32(version[8:], protocolVersion.GetPatch())
+	return append(res, version...)
+}
+
+//______________________________________________________________________
+// Query resolution
+
+func (w world) systemAccount() []byte {
+	namedKeys := [][]byte{
+		namedKeyBytes(types.MintContractName, storedvalue.KEY_ID_UREF, urefBytes(mintURef)),
+		namedKeyBytes(types.PosContractName, storedvalue.KEY_ID_UREF, urefBytes(posURef)),
+		namedKeyBytes(types.ProxyContractName, storedvalue.KEY_ID_HASH, proxyContractHash),
+	}
+	associatedKeys, _ := w.associatedKeys(grpc.SYSTEM_ACCOUNT)
+	return accountBytes(grpc.SYSTEM_ACCOUNT, namedKeys, purseAddress(grpc.SYSTEM_ACCOUNT), associatedKeys,
+		w.threshold(grpc.SYSTEM_ACCOUNT, deploymentAction), w.threshold(grpc.SYSTEM_ACCOUNT, keyManagementAction))
+}
+
+func (w world) userAccount(addr []byte) []byte {
+	namedKeys := [][]byte{
+		namedKeyBytes(types.MintContractName, storedvalue.KEY_ID_UREF, urefBytes(mintURef)),
+	}
+	associatedKeys, _ := w.associatedKeys(addr)
+	return accountBytes(addr, namedKeys, purseAddress(addr), associatedKeys,
+		w.threshold(addr, deploymentAction), w.threshold(addr, keyManagementAction))
+}
+
+// posContract exposes the PoS state as named keys the same way the PoS contract does
+func (w world) posContract(protocolVersion *state.ProtocolVersion) []byte {
+	names := []string{}
+
+	stakes := w.stakes()
+	validators, _ := w.entries(delegationPrefix)
+	seen := map[string]bool{}
+	for _, k := range validators {
+		if !seen[k[1]] {
+			seen[k[1]] = true
+			names = append(names, strings.Join([]string{storedvalue.VALIDATOR_PREFIX, k[1], stakes[k[1]].String()}, "_"))
+		}
+	}
+
+	prefixes := []struct {
+		prefix string
+		name   string
+	}{
+		{delegationPrefix, storedvalue.DELEGATE_PREFIX},
+		{votePrefix, storedvalue.VOTE_PREFIX},
+		{commissionPrefix, storedvalue.COMMISSION_PREFIX},
+		{rewardPrefix, storedvalue.REWARD_PREFIX},
+	}
+	for _, p := range prefixes {
+		keys, values := w.entries(p.prefix)
+		for i, k := range keys {
+			names = append(names, strings.Join(append(append([]string{p.name}, k...), values[i].String()), "_"))
+		}
+	}
+
+	namedKeys := [][]byte{}
+	for _, name := range names {
+		namedKeys = append(namedKeys, namedKeyBytes(name, storedvalue.KEY_ID_HASH, emptyHash))
+	}
+	return contractBytes(namedKeys, protocolVersion)
+}
+
+// resolveLocal finds the value behind a local key by trying every known address
+func (w world) resolveLocal(local []byte) ([]byte, bool) {
+	for _, addr := range w.addresses() {
+		if w.hasAccount(addr) && bytes.Equal(local, localKey(mintURef, purseAddress(addr))) {
+			return clValueBytes(append([]byte{byte(storedvalue.KEY_ID_UREF)}, urefBytes(balanceURef(addr))...), storedvalue.TAG_KEY), true
+		}
+
+		var value *big.Int
+		switch {
+		case bytes.Equal(local, posLocalKey(grpc.ACTION_PREFIX_STAKE, addr)):
+			value = w.sumBy(delegationPrefix, 0, addr)
+		case bytes.Equal(local, posLocalKey(grpc.ACTION_PREFIX_VOTING, addr)):
+			value = w.sumBy(votePrefix, 0, addr)
+		case bytes.Equal(local, posLocalKey(grpc.ACTION_PREFIX_VOTED, addr)):
+			value = w.sumBy(votePrefix, 1, addr)
+		case bytes.Equal(local, posLocalKey(grpc.PREFIX_COMMISSION, addr)):
+			value = w.get(commissionKey(addr))
+		case bytes.Equal(local, posLocalKey(grpc.PREFIX_REWARD, addr)):
+			value = w.get(rewardKey(addr))
+		default:
+			continue
+		}
+		return clValueBytes(u512Bytes(value), storedvalue.TAG_U512), true
+	}
+	return nil, false
+}
+
+func (w world) resolveURef(uref []byte, protocolVersion *state.ProtocolVersion) ([]byte, bool) {
+	switch {
+	case bytes.Equal(uref, mintURef):
+		return contractBytes([][]byte{}, protocolVersion), true
+	case bytes.Equal(uref, posURef):
+		return w.posContract(protocolVersion), true
+	}
+
+	for _, addr := range w.addresses() {
+		if w.hasAccount(addr) && bytes.Equal(uref, balanceURef(addr)) {
+			return clValueBytes(u512Bytes(w.get(balanceKey(addr))), storedvalue.TAG_U512), true
+		}
+	}
+	return nil, false
+}
+
+func (w world) resolve(key *state.Key, protocolVersion *state.ProtocolVersion) ([]byte, bool) {
+	switch key.GetValue().(type) {
+	case *state.Key_Address_:
+		addr := key.GetAddress().GetAccount()
+		if bytes.Equal(addr, grpc.SYSTEM_ACCOUNT) {
+			return w.systemAccount(), true
+		}
+		if w.hasAccount(addr) {
+			return w.userAccount(addr), true
+		}
+	case *state.Key_Hash_:
+		hash := key.GetHash().GetHash()
+		if bytes.Equal(hash, proxyContractHash) || w.get(contractKey(hash)).Sign() > 0 {
+			return contractBytes([][]byte{}, protocolVersion), true
+		}
+	case *state.Key_Uref:
+		return w.resolveURef(key.GetUref().GetUref(), protocolVersion)
+	case *state.Key_Local_:
+		return w.resolveLocal(key.GetLocal().GetHash())
+	}
+	return nil, false
+}
+
+// query resolves the base key and walks the named keys along the path
+func (w world) query(baseKey *state.Key, path []string, protocolVersion *state.ProtocolVersion) ([]byte, error) {
+	res, ok := w.resolve(baseKey, protocolVersion)
+	if !ok {
+		return nil, fmt.Errorf("Value not found: %s", baseKey.String())
+	}
+
+	for _, name := range path {
+		var sv storedvalue.StoredValue
+		sv, err, _ := sv.FromBytes(res)
+		if err != nil {
+			return nil, err
+		}
+
+		var namedKeys storedvalue.NamedKeys
+		switch sv.Type {
+		case storedvalue.TYPE_ACCOUNT:
+			namedKeys = sv.Account.NamedKeys
+		case storedvalue.TYPE_CONTRACT:
+			namedKeys = sv.Contract.NamedKeys
+		}
+
+		var next *state.Key
+		for _, namedKey := range namedKeys {
+			if namedKey.Name != name {
+				continue
+			}
+			switch namedKey.Key.KeyID {
+			case storedvalue.KEY_ID_HASH:
+				next = &state.Key{Value: &state.Key_Hash_{Hash: &state.Key_Hash{Hash: namedKey.Key.Hash}}}
+			case storedvalue.KEY_ID_UREF:
+				next = &state.Key{Value: &state.Key_Uref{Uref: &state.Key_URef{Uref: namedKey.Key.Uref.Address}}}
+			}
+			break
+		}
+		if next == nil {
+			return nil, fmt.Errorf("Name not found: %s", name)
+		}
+
+		res, ok = w.resolve(next, protocolVersion)
+		if !ok {
+			return nil, fmt.Errorf("Value not found: %s", name)
+		}
+	}
+
+	return res, nil
+}
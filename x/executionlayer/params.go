@@ -0,0 +1,102 @@
+package executionlayer
+
+import (
+	"time"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+)
+
+// SignedBlocksWindow - sliding window for downtime jailing
+func (k ExecutionLayerKeeper) SignedBlocksWindow(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.KeySignedBlocksWindow, &res)
+	return
+}
+
+// MinSignedPerWindow - downtime jailing threshold in blocks
+func (k ExecutionLayerKeeper) MinSignedPerWindow(ctx sdk.Context) int64 {
+	var minSignedPerWindow sdk.Dec
+	k.paramSpace.Get(ctx, types.KeyMinSignedPerWindow, &minSignedPerWindow)
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+
+	// NOTE: RoundInt64 will never panic as minSignedPerWindow is
+	//       not greater than 1.
+	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
+}
+
+// DowntimeJailDuration - period for which a validator missing blocks is jailed
+func (k ExecutionLayerKeeper) DowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyDowntimeJailDuration, &res)
+	return
+}
+
+// MaxEvidenceAge - max age for evidence of an infraction
+func (k ExecutionLayerKeeper) MaxEvidenceAge(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyMaxEvidenceAge, &res)
+	return
+}
+
+// SlashFractionDoubleSign - fraction of the stake slashed for a double sign
+func (k ExecutionLayerKeeper) SlashFractionDoubleSign(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySlashFractionDoubleSign, &res)
+	return
+}
+
+// MaxValidators - maximum number of validators in the validator set
+func (k ExecutionLayerKeeper) MaxValidators(ctx sdk.Context) (res uint16) {
+	k.paramSpace.Get(ctx, types.KeyMaxValidators, &res)
+	return
+}
+
+// GasPrice - price of a gas of deploys in bigsun
+func (k ExecutionLayerKeeper) GasPrice(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyGasPrice, &res)
+	return
+}
+
+// MinFee - minimum fee of a deploy in bigsun
+func (k ExecutionLayerKeeper) MinFee(ctx sdk.Context) (res sdk.Int) {
+	k.paramSpace.Get(ctx, types.KeyMinFee, &res)
+	return
+}
+
+// MaxDeploySize - maximum size of the wasm code of a deploy in bytes
+func (k ExecutionLayerKeeper) MaxDeploySize(ctx sdk.Context) (res uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxDeploySize, &res)
+	return
+}
+
+// MaxSessionArgsLength - maximum length of the encoded session args of a deploy in bytes
+func (k ExecutionLayerKeeper) MaxSessionArgsLength(ctx sdk.Context) (res uint32) {
+	k.paramSpace.Get(ctx, types.KeyMaxSessionArgsLength, &res)
+	return
+}
+
+// ExtendedProxy - whether the proxy contract implements the methods beyond the client api proxy
+func (k ExecutionLayerKeeper) ExtendedProxy(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyExtendedProxy, &res)
+	return
+}
+
+// MinCommissionRate - minimum commission rate of a validator
+func (k ExecutionLayerKeeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
+// MaxCommissionRate - maximum commission rate of a validator
+func (k ExecutionLayerKeeper) MaxCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMaxCommissionRate, &res)
+	return
+}
+
+// GetParams returns the total set of executionlayer parameters.
+func (k ExecutionLayerKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of executionlayer parameters.
+func (k ExecutionLayerKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
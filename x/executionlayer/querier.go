@@ -3,12 +3,14 @@ package executionlayer
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/grpc"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/storedvalue"
 	"github.com/hdac-io/friday/codec"
 	sdk "github.com/hdac-io/friday/types"
@@ -19,18 +21,32 @@ import (
 
 const (
 	QueryEEDetail        = "querydetail"
+	QueryEEState         = "querystate"
 	QueryEEBalanceDetail = "querybalancedetail"
 	QueryStakeDetail     = "querystakedetail"
 	QueryVoteDetail      = "queryvotedetail"
 
 	QueryValidator    = "queryvalidator"
 	QueryAllValidator = "queryallvalidator"
+	QueryValidatorSet = "queryvalidatorset"
 
 	QueryDelegator = "querydelegator"
 	QueryVoter     = "queryvoter"
 
 	QueryReward     = "queryreward"
 	QueryCommission = "querycommission"
+
+	QuerySimulate = "simulate"
+
+	QueryContract  = "querycontract"
+	QueryContracts = "querycontracts"
+
+	QueryEngineUpgrade = "queryupgrade"
+
+	QuerySigningInfo = "querysigninginfo"
+	QuerySlashes     = "queryslashes"
+
+	QueryParams = "queryparams"
 )
 
 // NewQuerier is the module level router for state queries
@@ -39,6 +55,8 @@ func NewQuerier(keeper ExecutionLayerKeeper) sdk.Querier {
 		switch path[0] {
 		case QueryEEDetail:
 			return queryEEDetail(ctx, path[1:], req, keeper)
+		case QueryEEState:
+			return queryEEState(ctx, req, keeper)
 		case QueryEEBalanceDetail:
 			return queryBalanceDetail(ctx, path[1:], req, keeper)
 		case QueryStakeDetail:
@@ -49,6 +67,8 @@ func NewQuerier(keeper ExecutionLayerKeeper) sdk.Querier {
 			return queryValidator(ctx, req, keeper)
 		case QueryAllValidator:
 			return queryAllValidator(ctx, req, keeper)
+		case QueryValidatorSet:
+			return queryValidatorSet(ctx, req, keeper)
 		case QueryDelegator:
 			return queryDelegator(ctx, req, keeper)
 		case QueryVoter:
@@ -57,6 +77,20 @@ func NewQuerier(keeper ExecutionLayerKeeper) sdk.Querier {
 			return queryReward(ctx, req, keeper)
 		case QueryCommission:
 			return queryCommission(ctx, req, keeper)
+		case QuerySimulate:
+			return querySimulate(ctx, req, keeper)
+		case QueryContract:
+			return queryContract(ctx, req, keeper)
+		case QueryContracts:
+			return queryContracts(ctx, req, keeper)
+		case QueryEngineUpgrade:
+			return queryEngineUpgrade(ctx, keeper)
+		case QuerySigningInfo:
+			return querySigningInfo(ctx, req, keeper)
+		case QuerySlashes:
+			return querySlashes(ctx, req, keeper)
+		case QueryParams:
+			return queryParams(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown ee query")
 		}
@@ -70,15 +104,132 @@ func queryEEDetail(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
 	}
 
-	ctx = ctx.WithBlockHeight(req.Height)
-	res, err := getQueryResult(ctx, keeper, param.KeyType, param.KeyData, param.Path)
+	res, sdkErr := getQueryResultAtHeight(ctx, keeper, req.Height, param.KeyType, param.KeyData, param.Path)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	return res, nil
+}
+
+// queryEEState decodes a stored value and walks its named keys to the given depth
+func queryEEState(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
+	var param types.QueryStateParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &param)
 	if err != nil {
-		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, err.Error())
+		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
+	}
+	if param.Depth < 0 || param.Depth > types.MaxStateDepth {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("depth must be between 0 and %d", types.MaxStateDepth))
+	}
+
+	eeState, sdkErr := getEEState(ctx, keeper, req.GetHeight())
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	res, err := queryState(ctx, keeper, eeState, param.KeyType, param.KeyData, param.Path)
+	if err != nil {
+		return nil, queryFailure(req.GetHeight(), err.Error())
+	}
+
+	explorer := stateExplorer{
+		client:          keeper.client,
+		stateHash:       eeState,
+		protocolVersion: keeper.GetProtocolVersion(ctx),
+		cache:           map[types.KeyState]stateQueryResult{},
+	}
+	storedState, err := explorer.decode(res, param.Depth)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not decode stored value", err.Error()))
+	}
+
+	res, err = json.MarshalIndent(storedState, "", "  ")
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+type stateQueryResult struct {
+	value  []byte
+	errMsg string
+}
+
+// stateExplorer walks the named keys of a state of the execution engine.
+// Each key is queried once, even when many named keys point to it.
+type stateExplorer struct {
+	client          ipc.ExecutionEngineServiceClient
+	stateHash       []byte
+	protocolVersion state.ProtocolVersion
+	cache           map[types.KeyState]stateQueryResult
+}
+
+func (e stateExplorer) decode(bz []byte, depth int) (types.StoredState, error) {
+	var storedValue storedvalue.StoredValue
+	storedValue, err, _ := storedValue.FromBytes(bz)
+	if err != nil {
+		return types.StoredState{}, err
 	}
+	res := types.NewStoredState(storedValue)
 
+	if res.Account != nil {
+		balance, errMsg := grpc.QueryBalance(e.client, e.stateHash, storedValue.Account.PublicKey, &e.protocolVersion)
+		if errMsg == "" {
+			res.Account.Balance = balance
+		}
+	}
+	if depth == 0 {
+		return res, nil
+	}
+
+	namedKeys := res.NamedKeys()
+	for i, namedKey := range namedKeys {
+		value, errMsg := e.query(namedKey.Key)
+		if errMsg != "" {
+			namedKeys[i].Error = errMsg
+			continue
+		}
+		if value == nil {
+			continue
+		}
+		child, err := e.decode(value, depth-1)
+		if err != nil {
+			namedKeys[i].Error = err.Error()
+			continue
+		}
+		namedKeys[i].Value = &child
+	}
 	return res, nil
 }
 
+// query returns the stored value of a key. Local keys and empty keys, which point nowhere, are not queried.
+func (e stateExplorer) query(key types.KeyState) ([]byte, string) {
+	if res, ok := e.cache[key]; ok {
+		return res.value, res.errMsg
+	}
+
+	var keyBytes []byte
+	var err error
+	switch key.KeyType {
+	case types.ADDRESS:
+		keyBytes, err = sdk.AccAddressFromBech32(key.KeyData)
+	case types.HASH:
+		keyBytes, err = sdk.ContractHashAddressFromBech32(key.KeyData)
+	case types.UREF:
+		keyBytes, err = sdk.ContractUrefAddressFromBech32(key.KeyData)
+	}
+	if err != nil {
+		return nil, err.Error()
+	}
+	if len(keyBytes) == 0 || bytes.Equal(keyBytes, make([]byte, len(keyBytes))) {
+		return nil, ""
+	}
+
+	value, errMsg := grpc.Query(e.client, e.stateHash, key.KeyType, keyBytes, []string{}, &e.protocolVersion)
+	e.cache[key] = stateQueryResult{value: value, errMsg: errMsg}
+	return value, errMsg
+}
+
 func queryBalanceDetail(ctx sdk.Context, path []string, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
 	var param QueryGetBalanceDetail
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &param)
@@ -86,11 +237,14 @@ func queryBalanceDetail(ctx sdk.Context, path []string, req abci.RequestQuery, k
 		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
 	}
 
-	eeState := keeper.GetUnitHashMap(ctx, req.GetHeight()).EEState
+	eeState, sdkErr := getEEState(ctx, keeper, req.GetHeight())
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	protocolVersion := keeper.GetProtocolVersion(ctx)
 	val, errMsg := grpc.QueryBalance(keeper.client, eeState, param.Address, &protocolVersion)
 	if errMsg != "" {
-		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", errMsg)
+		return nil, queryFailure(req.GetHeight(), errMsg)
 	}
 
 	queryvalue := &state.Value{Value: &state.Value_StringValue{StringValue: val}}
@@ -112,11 +266,14 @@ func queryStakeDetail(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLa
 		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
 	}
 
-	eeState := keeper.GetUnitHashMap(ctx, req.GetHeight()).EEState
+	eeState, sdkErr := getEEState(ctx, keeper, req.GetHeight())
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	protocolVersion := keeper.GetProtocolVersion(ctx)
 	val, errMsg := grpc.QueryStake(keeper.client, eeState, param.Address, &protocolVersion)
 	if errMsg != "" {
-		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", errMsg)
+		return nil, queryFailure(req.GetHeight(), errMsg)
 	}
 
 	queryvalue := &state.Value{Value: &state.Value_StringValue{StringValue: val}}
@@ -138,7 +295,10 @@ func queryVoteDetail(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLay
 		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
 	}
 
-	eeState := keeper.GetUnitHashMap(ctx, req.GetHeight()).EEState
+	eeState, sdkErr := getEEState(ctx, keeper, req.GetHeight())
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	protocolVersion := keeper.GetProtocolVersion(ctx)
 
 	val := ""
@@ -146,7 +306,7 @@ func queryVoteDetail(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLay
 	if !param.Address.Empty() {
 		val, errMsg = grpc.QueryVoting(keeper.client, eeState, param.Address, &protocolVersion)
 		if errMsg != "" {
-			return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", errMsg)
+			return nil, queryFailure(req.GetHeight(), errMsg)
 		}
 	} else if param.Dapp != "" {
 		var key storedvalue.Key
@@ -169,7 +329,7 @@ func queryVoteDetail(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLay
 		}
 		val, errMsg = grpc.QueryVoted(keeper.client, eeState, key.ToBytes(), &protocolVersion)
 		if errMsg != "" {
-			return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", errMsg)
+			return nil, queryFailure(req.GetHeight(), errMsg)
 		}
 	}
 
@@ -197,8 +357,10 @@ func queryValidator(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLaye
 		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, err.Error())
 	}
 
-	ctx = ctx.WithBlockHeight(req.Height)
-	res, err := getQueryResult(ctx, keeper, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	res, sdkErr := getQueryResultAtHeight(ctx, keeper, req.Height, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	var storedValue storedvalue.StoredValue
 	storedValue, err, _ = storedValue.FromBytes(res)
 	if err != nil {
@@ -218,10 +380,12 @@ func queryValidator(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLaye
 func queryAllValidator(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
 	validators := keeper.GetAllValidators(ctx)
 
-	ctx = ctx.WithBlockHeight(req.Height)
-	res, err := getQueryResult(ctx, keeper, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	res, sdkErr := getQueryResultAtHeight(ctx, keeper, req.Height, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	var storedValue storedvalue.StoredValue
-	storedValue, err, _ = storedValue.FromBytes(res)
+	storedValue, err, _ := storedValue.FromBytes(res)
 	if err != nil {
 		return []byte{}, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
 	}
@@ -243,6 +407,31 @@ func queryAllValidator(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionL
 
 // GetQueryResult queries with whole parameters
 func getQueryResult(ctx sdk.Context, k ExecutionLayerKeeper,
+	keyType string, keyData string, path string) ([]byte, error) {
+	stateHash := k.GetUnitHashMap(ctx, ctx.BlockHeight()).EEState
+	if len(stateHash) == 0 {
+		stateHash = ctx.CandidateBlock().State
+	}
+
+	return queryState(ctx, k, stateHash, keyType, keyData, path)
+}
+
+// getQueryResultAtHeight queries the state of the execution engine committed at the height
+func getQueryResultAtHeight(ctx sdk.Context, k ExecutionLayerKeeper, height int64,
+	keyType string, keyData string, path string) ([]byte, sdk.Error) {
+	stateHash, sdkErr := getEEState(ctx, k, height)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := queryState(ctx, k, stateHash, keyType, keyData, path)
+	if err != nil {
+		return nil, queryFailure(height, err.Error())
+	}
+	return res, nil
+}
+
+func queryState(ctx sdk.Context, k ExecutionLayerKeeper, stateHash []byte,
 	keyType string, keyData string, path string) ([]byte, error) {
 	arrPath := []string{}
 	if path != "" {
@@ -250,10 +439,6 @@ func getQueryResult(ctx sdk.Context, k ExecutionLayerKeeper,
 	}
 
 	protocolVersion := k.GetProtocolVersion(ctx)
-	stateHash := k.GetUnitHashMap(ctx, ctx.BlockHeight()).EEState
-	if len(stateHash) == 0 {
-		stateHash = ctx.CandidateBlock().State
-	}
 	keyDataBytes, err := toBytes(keyType, keyData, k.NicknameKeeper, ctx)
 	if err != nil {
 		return []byte{}, err
@@ -266,6 +451,46 @@ func getQueryResult(ctx sdk.Context, k ExecutionLayerKeeper,
 	return res, nil
 }
 
+// getEEState returns the state hash of the execution engine committed at the height
+func getEEState(ctx sdk.Context, k ExecutionLayerKeeper, height int64) ([]byte, sdk.Error) {
+	eeState := k.GetUnitHashMap(ctx, height).EEState
+	if len(eeState) == 0 {
+		return nil, types.ErrEEStatePruned(types.DefaultCodespace, height)
+	}
+	return eeState, nil
+}
+
+// queryFailure returns the error of a failed query.
+// The engine does not find the root of a state which it has pruned.
+func queryFailure(height int64, errMsg string) sdk.Error {
+	if strings.Contains(strings.ToLower(errMsg), "root not found") {
+		return types.ErrEEStatePruned(types.DefaultCodespace, height)
+	}
+	return types.ErrGRpcQueryFailure(types.DefaultCodespace, errMsg)
+}
+
+// queryValidatorSet ranks the validators by their stakes at the height into the active and candidate sets
+func queryValidatorSet(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
+	res, sdkErr := getQueryResultAtHeight(ctx, keeper, req.Height, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	var storedValue storedvalue.StoredValue
+	storedValue, err, _ := storedValue.FromBytes(res)
+	if err != nil {
+		return []byte{}, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
+	}
+
+	validatorSet := keeper.GetValidatorSet(ctx, storedValue.Contract.NamedKeys.GetAllValidators())
+
+	res, err = codec.MarshalJSONIndent(types.ModuleCdc, validatorSet)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
 func queryDelegator(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
 	var param QueryDelegatorParams
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &param)
@@ -273,8 +498,10 @@ func queryDelegator(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLaye
 		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
 	}
 
-	ctx = ctx.WithBlockHeight(req.Height)
-	res, err := getQueryResult(ctx, keeper, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	res, sdkErr := getQueryResultAtHeight(ctx, keeper, req.Height, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	var storedValue storedvalue.StoredValue
 	storedValue, err, _ = storedValue.FromBytes(res)
 	if err != nil {
@@ -340,8 +567,10 @@ func queryVoter(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKee
 		contractKey = storedvalue.NewKeyFromURef(uref)
 	}
 
-	ctx = ctx.WithBlockHeight(req.Height)
-	res, err := getQueryResult(ctx, keeper, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	res, sdkErr := getQueryResultAtHeight(ctx, keeper, req.Height, types.ADDRESS, types.SYSTEM, types.PosContractName)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	var storedValue storedvalue.StoredValue
 	storedValue, err, _ = storedValue.FromBytes(res)
 	if err != nil {
@@ -413,11 +642,14 @@ func queryReward(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKe
 		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
 	}
 
-	eeState := keeper.GetUnitHashMap(ctx, req.GetHeight()).EEState
+	eeState, sdkErr := getEEState(ctx, keeper, req.GetHeight())
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	protocolVersion := keeper.GetProtocolVersion(ctx)
 	val, errMsg := grpc.QueryReward(keeper.client, eeState, param.Address, &protocolVersion)
 	if errMsg != "" {
-		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", errMsg)
+		return nil, queryFailure(req.GetHeight(), errMsg)
 	}
 
 	queryvalue := &state.Value{Value: &state.Value_StringValue{StringValue: val}}
@@ -439,11 +671,14 @@ func queryCommission(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLay
 		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
 	}
 
-	eeState := keeper.GetUnitHashMap(ctx, req.GetHeight()).EEState
+	eeState, sdkErr := getEEState(ctx, keeper, req.GetHeight())
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	protocolVersion := keeper.GetProtocolVersion(ctx)
 	val, errMsg := grpc.QueryCommission(keeper.client, eeState, param.Address, &protocolVersion)
 	if errMsg != "" {
-		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", errMsg)
+		return nil, queryFailure(req.GetHeight(), errMsg)
 	}
 
 	queryvalue := &state.Value{Value: &state.Value_StringValue{StringValue: val}}
@@ -457,3 +692,139 @@ func queryCommission(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLay
 	}
 	return res.Bytes(), nil
 }
+
+// querySimulate runs a message on the last committed state without committing its result
+func querySimulate(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
+	var param types.QuerySimulateParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &param)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("could not decode message", err.Error()))
+	}
+	if param.Msg == nil || param.Msg.Route() != types.RouterKey {
+		return nil, sdk.ErrUnknownRequest("only executionlayer messages can be simulated")
+	}
+	if err := param.Msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// the store of a query is not committed
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	result, err := types.NewSimulateResult(NewHandler(keeper)(ctx, param.Msg, true, 0, 0), keeper.GasPrice(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not decode deploy results", err.Error()))
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+// queryContract returns the contract registered as the name
+func queryContract(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
+	var param types.QueryContractParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &param)
+	if err != nil {
+		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
+	}
+
+	contract, found := keeper.GetRegisteredContract(ctx, param.Name)
+	if !found {
+		return nil, types.ErrContractNotFound(types.DefaultCodespace, param.Name)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, contract)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+// queryContracts returns the contracts registered by the deployer, or every registered contract
+func queryContracts(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
+	var param types.QueryContractsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &param)
+	if err != nil {
+		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
+	}
+
+	var contracts types.RegisteredContracts
+	if param.DeployerAddr.Empty() {
+		contracts = keeper.GetAllRegisteredContracts(ctx)
+	} else {
+		contracts = keeper.GetRegisteredContractsByDeployer(ctx, param.DeployerAddr)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, contracts)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryEngineUpgrade(ctx sdk.Context, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
+	result := types.QueryEngineUpgradeResult{
+		ProtocolVersion: types.ProtocolVersionString(keeper.GetProtocolVersion(ctx)),
+		Applied:         keeper.GetAppliedEngineUpgrades(ctx),
+	}
+	if scheduled, found := keeper.GetScheduledEngineUpgrade(ctx); found {
+		result.Scheduled = &scheduled
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func querySigningInfo(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
+	var param types.QueryValidatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &param)
+	if err != nil {
+		return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
+	}
+
+	validator, found := keeper.GetValidator(ctx, param.ValidatorAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound(types.DefaultCodespace)
+	}
+	signingInfo, found := keeper.GetValidatorSigningInfo(ctx, validator.ConsAddress())
+	if !found {
+		return nil, types.ErrNoSigningInfoFound(types.DefaultCodespace, validator.ConsAddress())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, signingInfo)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+// querySlashes returns the slash history of a validator, or of every validator without the params
+func querySlashes(ctx sdk.Context, req abci.RequestQuery, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
+	records := keeper.GetAllSlashRecords(ctx)
+	if len(req.Data) != 0 {
+		var param types.QueryValidatorParams
+		err := types.ModuleCdc.UnmarshalJSON(req.Data, &param)
+		if err != nil {
+			return nil, sdk.NewError(sdk.CodespaceUndefined, sdk.CodeUnknownRequest, "Bad request: {}", err.Error())
+		}
+		records = keeper.GetSlashRecordsByValidator(ctx, param.ValidatorAddr)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, keeper ExecutionLayerKeeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,266 @@
+package executionlayer
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+	abci "github.com/hdac-io/tendermint/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerySimulate(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	querier := NewQuerier(input.elk)
+
+	simulate := func(msg sdk.Msg) types.SimulateResult {
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, QuerySimulate),
+			Data: input.cdc.MustMarshalJSON(types.NewQuerySimulateParams(msg)),
+		}
+		bz, err := querier(input.ctx, []string{QuerySimulate}, req)
+		require.Nil(t, err, fmt.Sprint(err))
+
+		var result types.SimulateResult
+		input.cdc.MustUnmarshalJSON(bz, &result)
+		return result
+	}
+
+	result := simulate(types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000", types.BASIC_FEE))
+	require.True(t, result.Success)
+	require.NotZero(t, result.GasCost)
+	require.Equal(t, types.SuggestedFee(result.GasCost, types.DefaultGasPrice), result.SuggestedFee)
+	require.Len(t, result.Deploys, 1)
+
+	// the simulated transfer is not committed
+	simulated := simulate(types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000", types.BASIC_FEE))
+	require.Equal(t, result.GasCost, simulated.GasCost)
+
+	result = simulate(types.NewMsgTransfer(ContractAddress, GenesisAccountAddress, RecipientAccountAddress, "1000000000000000000", types.BASIC_FEE))
+	require.False(t, result.Success)
+	require.Equal(t, types.DefaultCodespace, result.Codespace)
+	require.Equal(t, types.CodeGRpcExecuteDeployExecError, result.Code)
+	require.Contains(t, result.Error, "execution engine - deploy error - execute")
+	require.NotZero(t, result.GasCost)
+}
+
+func TestQueryHistoricalHeight(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	querier := NewQuerier(input.elk)
+
+	balance := func(height int64) sdk.Error {
+		req := abci.RequestQuery{
+			Path:   fmt.Sprintf("custom/%s/%s", types.ModuleName, QueryEEBalanceDetail),
+			Data:   input.cdc.MustMarshalJSON(types.QueryGetBalanceDetail{Address: GenesisAccountAddress}),
+			Height: height,
+		}
+		_, err := querier(input.ctx, []string{QueryEEBalanceDetail}, req)
+		return err
+	}
+	delegators := func(height int64) sdk.Error {
+		req := abci.RequestQuery{
+			Path:   fmt.Sprintf("custom/%s/%s", types.ModuleName, QueryDelegator),
+			Data:   input.cdc.MustMarshalJSON(types.NewQueryDelegatorParams(GenesisAccountAddress, nil)),
+			Height: height,
+		}
+		_, err := querier(input.ctx, []string{QueryDelegator}, req)
+		return err
+	}
+
+	// the state of genesis
+	require.Nil(t, balance(0))
+	require.Nil(t, delegators(0))
+
+	// no state is stored at the height
+	err := balance(5)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeEEStatePruned, err.Code())
+	err = delegators(5)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeEEStatePruned, err.Code())
+
+	// the state is stored, but the engine does not have it anymore
+	input.elk.SetUnitHashMap(input.ctx.WithBlockHeight(6), types.NewUnitHashMap(make([]byte, 32)))
+	err = balance(6)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeEEStatePruned, err.Code())
+	err = delegators(6)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeEEStatePruned, err.Code())
+}
+
+func TestQueryEEState(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	querier := NewQuerier(input.elk)
+
+	query := func(keyType, keyData string, depth int) (types.StoredState, sdk.Error) {
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, QueryEEState),
+			Data: input.cdc.MustMarshalJSON(types.QueryStateParams{KeyType: keyType, KeyData: keyData, Depth: depth}),
+		}
+		res, err := querier(input.ctx, []string{QueryEEState}, req)
+		var storedState types.StoredState
+		if err == nil {
+			require.NoError(t, json.Unmarshal(res, &storedState))
+		}
+		return storedState, err
+	}
+
+	// an account with its balance, but without walking its named keys
+	account, err := query(types.ADDRESS, GenesisAccountAddress.String(), 0)
+	require.Nil(t, err)
+	require.Equal(t, types.StoredTypeAccount, account.Type)
+	require.Equal(t, GenesisAccountAddress.String(), account.Account.Address)
+	require.NotEmpty(t, account.Account.Balance)
+	require.Equal(t, []types.AssociatedKeyState{{Address: GenesisAccountAddress.String(), Weight: 1}}, account.Account.AssociatedKeys)
+	require.Equal(t, types.ActionThresholdsState{Deployment: 1, KeyManagement: 1}, account.Account.ActionThresholds)
+	require.NotEmpty(t, account.Account.NamedKeys)
+	for _, namedKey := range account.Account.NamedKeys {
+		require.Nil(t, namedKey.Value)
+	}
+
+	// the system contracts are walked from the system account
+	protocolVersion := types.ProtocolVersionString(input.elk.GetProtocolVersion(input.ctx))
+	system, err := query(types.ADDRESS, types.SYSTEM, 1)
+	require.Nil(t, err)
+	require.Len(t, system.Account.NamedKeys, 3)
+	for _, namedKey := range system.Account.NamedKeys {
+		require.Empty(t, namedKey.Error, namedKey.Name)
+		require.NotNil(t, namedKey.Value, namedKey.Name)
+		require.Equal(t, types.StoredTypeContract, namedKey.Value.Type)
+		require.Equal(t, protocolVersion, namedKey.Value.Contract.ProtocolVersion)
+	}
+
+	_, err = query(types.ADDRESS, types.SYSTEM, types.MaxStateDepth+1)
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
+
+func TestQueryContracts(t *testing.T) {
+	input := setupTestInput()
+	querier := NewQuerier(input.elk)
+
+	counter := types.NewRegisteredContract("counter", GenesisAccountAddress, "00", "fridaycontracthash1", 1, "")
+	token := types.NewRegisteredContract("token", RecipientAccountAddress, "01", "fridaycontracthash2", 2, "")
+	input.elk.SetRegisteredContract(input.ctx, counter)
+	input.elk.SetRegisteredContract(input.ctx, token)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, QueryContract),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryContractParams("token")),
+	}
+	bz, err := querier(input.ctx, []string{QueryContract}, req)
+	require.Nil(t, err, fmt.Sprint(err))
+	var contract types.RegisteredContract
+	input.cdc.MustUnmarshalJSON(bz, &contract)
+	require.Equal(t, token, contract)
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryContractParams("unknown"))
+	_, err = querier(input.ctx, []string{QueryContract}, req)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeContractNotFound, err.Code())
+
+	query := func(deployer sdk.AccAddress) types.RegisteredContracts {
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, QueryContracts),
+			Data: input.cdc.MustMarshalJSON(types.NewQueryContractsParams(deployer)),
+		}
+		bz, err := querier(input.ctx, []string{QueryContracts}, req)
+		require.Nil(t, err, fmt.Sprint(err))
+		var contracts types.RegisteredContracts
+		input.cdc.MustUnmarshalJSON(bz, &contracts)
+		return contracts
+	}
+	require.Equal(t, types.RegisteredContracts{counter}, query(GenesisAccountAddress))
+	require.Equal(t, types.RegisteredContracts{counter, token}, query(nil))
+}
+
+func TestQueryEngineUpgrade(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	querier := NewQuerier(input.elk)
+
+	proposal := types.NewEngineUpgradeProposal("title", "description", 10, "2.0.0", nil, nil)
+	input.elk.SetScheduledEngineUpgrade(input.ctx, proposal)
+	applied := types.NewEngineUpgrade(5, input.elk.GetProtocolVersion(input.ctx), []byte{1, 2})
+	input.elk.SetAppliedEngineUpgrade(input.ctx, applied)
+
+	req := abci.RequestQuery{Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, QueryEngineUpgrade)}
+	bz, err := querier(input.ctx, []string{QueryEngineUpgrade}, req)
+	require.Nil(t, err, fmt.Sprint(err))
+
+	var result types.QueryEngineUpgradeResult
+	input.cdc.MustUnmarshalJSON(bz, &result)
+	require.Equal(t, types.ProtocolVersionString(input.elk.GetProtocolVersion(input.ctx)), result.ProtocolVersion)
+	require.Equal(t, &proposal, result.Scheduled)
+	require.Equal(t, []types.EngineUpgrade{applied}, result.Applied)
+}
+
+func TestQuerySlashes(t *testing.T) {
+	input := setupTestInput()
+	querier := NewQuerier(input.elk)
+
+	slashTime := time.Unix(1583712000, 0).UTC()
+	first := types.NewSlashRecord(GenesisAccountAddress, sdk.ConsAddress(GenesisAccountAddress), 1, 2,
+		slashTime, types.DefaultSlashFractionDoubleSign, "50000", types.AttributeValueDoubleSign)
+	second := types.NewSlashRecord(RecipientAccountAddress, sdk.ConsAddress(RecipientAccountAddress), 3, 4,
+		slashTime, types.DefaultSlashFractionDoubleSign, "70000", types.AttributeValueDoubleSign)
+	input.elk.SetSlashRecord(input.ctx, first)
+	input.elk.SetSlashRecord(input.ctx, second)
+
+	query := func(data []byte) types.SlashRecords {
+		req := abci.RequestQuery{Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, QuerySlashes), Data: data}
+		bz, err := querier(input.ctx, []string{QuerySlashes}, req)
+		require.Nil(t, err, fmt.Sprint(err))
+		var records types.SlashRecords
+		input.cdc.MustUnmarshalJSON(bz, &records)
+		return records
+	}
+	require.Equal(t, types.SlashRecords{second}, query(input.cdc.MustMarshalJSON(types.NewQueryValidatorParams(RecipientAccountAddress))))
+	require.Len(t, query(nil), 2)
+}
+
+func TestQueryValidatorSet(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	querier := NewQuerier(input.elk)
+
+	consPubKey, _ := sdk.GetConsPubKeyBech32("fridayvalconspub16jrl8jvqq98x7jjxfcm8252pwd4nv6fetpzk6nzx2ddyc3fn0p2rz4mwf44nqjtfga5k5at4xad82sjhx9r9zdfcwuc5uvt90934jjr4d4xk242909rxks28v9erv3jvwfcx2wp4fe8h54fsddu9zar5v3tyknrs8pykk2mw2p29j4n6w455c7j2d3x4ykft9akx6s24gsu8ys2nvayrykqst965z")
+	validator := types.NewValidator(GenesisAccountAddress, consPubKey, types.Description{}, "")
+	input.elk.SetValidator(input.ctx, GenesisAccountAddress, validator)
+
+	req := abci.RequestQuery{Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, QueryValidatorSet)}
+	bz, err := querier(input.ctx, []string{QueryValidatorSet}, req)
+	require.Nil(t, err, fmt.Sprint(err))
+
+	var validatorSet types.ValidatorSet
+	input.cdc.MustUnmarshalJSON(bz, &validatorSet)
+	require.Equal(t, types.DefaultMaxValidators, validatorSet.MaxValidators)
+	require.Len(t, validatorSet.Active, 1)
+	require.Equal(t, "1000000", validatorSet.Active[0].Stake)
+	require.Empty(t, validatorSet.Candidates)
+}
+
+func TestQueryParams(t *testing.T) {
+	input := setupTestInput()
+	genesis(input)
+	querier := NewQuerier(input.elk)
+
+	params := types.DefaultParams()
+	params.MinFee = sdk.NewInt(100)
+	input.elk.SetParams(input.ctx, params)
+
+	req := abci.RequestQuery{Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, QueryParams)}
+	bz, err := querier(input.ctx, []string{QueryParams}, req)
+	require.Nil(t, err, fmt.Sprint(err))
+
+	var res types.Params
+	input.cdc.MustUnmarshalJSON(bz, &res)
+	require.Equal(t, params, res)
+}
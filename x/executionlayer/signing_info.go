@@ -0,0 +1,99 @@
+package executionlayer
+
+import (
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+)
+
+// GetValidatorSigningInfo returns the signing info of a validator by its consensus address
+func (k ExecutionLayerKeeper) GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (info types.ValidatorSigningInfo, found bool) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	bz := store.Get(types.GetValidatorSigningInfoKey(address))
+	if bz == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &info)
+	return info, true
+}
+
+// SetValidatorSigningInfo sets the signing info of a validator by its consensus address
+func (k ExecutionLayerKeeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress, info types.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(info)
+	store.Set(types.GetValidatorSigningInfoKey(address), bz)
+}
+
+// GetAllValidatorSigningInfos returns the signing info of every validator
+func (k ExecutionLayerKeeper) GetAllValidatorSigningInfos(ctx sdk.Context) (infos []types.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorSigningInfoKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ValidatorSigningInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &info)
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+func (k ExecutionLayerKeeper) getValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) (missed bool) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	bz := store.Get(types.GetValidatorMissedBlockBitArrayKey(address, index))
+	if bz == nil {
+		// lazy: treat empty key as not missed
+		return false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &missed)
+	return missed
+}
+
+func (k ExecutionLayerKeeper) setValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(missed)
+	store.Set(types.GetValidatorMissedBlockBitArrayKey(address, index), bz)
+}
+
+func (k ExecutionLayerKeeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitArrayPrefixKey(address))
+	defer iter.Close()
+
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetSlashRecord records a slash in the slash history of a validator
+func (k ExecutionLayerKeeper) SetSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	store.Set(types.GetSlashRecordKey(record.Validator, record.Height), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// GetSlashRecordsByValidator returns the slash history of a validator, sorted by height
+func (k ExecutionLayerKeeper) GetSlashRecordsByValidator(ctx sdk.Context, operatorAddr sdk.AccAddress) types.SlashRecords {
+	return k.getSlashRecords(ctx, types.GetSlashRecordsByValidatorKey(operatorAddr))
+}
+
+// GetAllSlashRecords returns the slash history of every validator
+func (k ExecutionLayerKeeper) GetAllSlashRecords(ctx sdk.Context) types.SlashRecords {
+	return k.getSlashRecords(ctx, types.SlashRecordKey)
+}
+
+func (k ExecutionLayerKeeper) getSlashRecords(ctx sdk.Context, prefix []byte) types.SlashRecords {
+	store := ctx.KVStore(k.HashMapStoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	records := types.SlashRecords{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
@@ -7,8 +7,6 @@ import (
 	"time"
 
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/grpc"
-	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus"
-	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/storedvalue"
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
 	"github.com/hdac-io/friday/codec"
@@ -29,9 +27,11 @@ const (
 )
 
 var (
-	ContractAddress            = "friday15evpva2u57vv6l5czehyk1111111111111"
-	GenesisAccountAddress, _   = sdk.AccAddressFromBech32("friday15evpva2u57vv6l5czehyk69s0wnq9hrkqulwfz")
-	RecipientAccountAddress, _ = sdk.AccAddressFromBech32("friday1y2dx0evs5k6hxuhfrfdmm7wcwsrqr073htghpv")
+	ContractAddress = "friday15evpva2u57vv6l5czehyk1111111111111"
+
+	// Account addresses are 32 bytes long. Shorter ones fail to decode, and would leave the fixtures nil.
+	GenesisAccountAddress   = mustAccAddress("friday1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5z5tpwxqergd3c8g7rusqchrrt2")
+	RecipientAccountAddress = mustAccAddress("friday1v3jkvemgd94xkmrddehhqutjwd682anh0puh57mu04l8lqyps2psckz5ka")
 
 	contractPath        = os.ExpandEnv("$HOME/.nodef/contracts")
 	mintInstallWasm     = "mint_install.wasm"
@@ -42,6 +42,17 @@ var (
 	transferWasm        = "transfer_to_account.wasm"
 )
 
+// mockWasm is the wasm magic number, which stands for any contract on the mock engine
+var mockWasm = []byte{0x00, 0x61, 0x73, 0x6d}
+
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 type testInput struct {
 	cdc *codec.Codec
 	ctx sdk.Context
@@ -52,6 +63,7 @@ func setupTestInput() testInput {
 	db := dbm.NewMemDB()
 
 	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
 	types.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 
@@ -63,6 +75,8 @@ func setupTestInput() testInput {
 	tkeyParams := sdk.NewTransientStoreKey("transient_subspace")
 
 	ps := subspace.NewSubspace(cdc, keyParams, tkeyParams, authtypes.DefaultParamspace)
+	elps := subspace.NewSubspace(cdc, keyParams, tkeyParams, types.DefaultParamspace)
+	nnps := subspace.NewSubspace(cdc, keyParams, tkeyParams, nickname.DefaultParamspace)
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
@@ -74,13 +88,14 @@ func setupTestInput() testInput {
 
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: chainID}, false, log.NewNopLogger())
 	accountKeeper := auth.NewAccountKeeper(cdc, authCapKey, ps, auth.ProtoBaseAccount)
-	nicknameKeeper := nickname.NewNicknameKeeper(nicknameStoreKey, cdc, accountKeeper)
+	nicknameKeeper := nickname.NewNicknameKeeper(nicknameStoreKey, cdc, nnps, accountKeeper)
 
-	elk := NewExecutionLayerKeeper(cdc, hashMapStoreKey, os.ExpandEnv("$HOME/.casperlabs/.casper-node.sock"),
+	elk := NewExecutionLayerKeeper(cdc, hashMapStoreKey, elps, NewEngineConfig(MockEngineAddress), log.NewNopLogger(),
 		accountKeeper, nicknameKeeper)
+	elk.SetParams(ctx, types.DefaultParams())
 
-	gs := types.DefaultGenesisState()
-	gs.ChainName = chainID
+	// the mock engine does not interpret the system contracts, so no wasm file is read
+	gs := types.NewGenesisState(types.DefaultGenesisConf(mockWasm, mockWasm, mockWasm), nil, chainID, nil, nil, types.DefaultParams(), nil, nil)
 	gs.Accounts = make([]types.Account, 1)
 	gs.Accounts[0] = types.Account{
 		Address:             GenesisAccountAddress,
@@ -147,21 +162,9 @@ func counterDefine(keeper ExecutionLayerKeeper, parentStateHash []byte) []byte {
 	input := setupTestInput()
 	proxyHash := keeper.GetProxyContractHash(input.ctx)
 	timestamp := time.Now().Unix()
-	paymentArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.PaymentMethodName}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_U512{
-						U512: &state.CLValueInstance_U512{
-							Value: "1000000000000000"}}}}}}
-	paymentArgsJson, err := DeployArgsToJsonString(paymentArgs)
+	paymentArgsJson, err := types.NewMethodArgs(types.PaymentMethodName).
+		Add("", types.U512Value("1000000000000000")).
+		ToJSON()
 	if err != nil {
 		panic(err)
 	}
@@ -196,21 +199,9 @@ func counterCall(keeper ExecutionLayerKeeper, parentStateHash []byte) []byte {
 	input := setupTestInput()
 	proxyHash := keeper.GetProxyContractHash(input.ctx)
 	timestamp := time.Now().Unix()
-	paymentArgs := []*consensus.Deploy_Arg{
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_STRING}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_StrValue{
-						StrValue: types.PaymentMethodName}}}},
-		&consensus.Deploy_Arg{
-			Value: &state.CLValueInstance{
-				ClType: &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: state.CLType_U512}},
-				Value: &state.CLValueInstance_Value{
-					Value: &state.CLValueInstance_Value_U512{
-						U512: &state.CLValueInstance_U512{
-							Value: "1000000000000000"}}}}}}
-	paymentArgsJson, err := DeployArgsToJsonString(paymentArgs)
+	paymentArgsJson, err := types.NewMethodArgs(types.PaymentMethodName).
+		Add("", types.U512Value("1000000000000000")).
+		ToJSON()
 	if err != nil {
 		panic(err)
 	}
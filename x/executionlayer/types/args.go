@@ -0,0 +1,664 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+// type tags of the ABI of the execution engine
+const (
+	abiTagU8        = byte(state.CLType_U8)
+	abiTagU512      = byte(state.CLType_U512)
+	abiTagString    = byte(state.CLType_STRING)
+	abiTagKey       = byte(state.CLType_KEY)
+	abiTagOption    = byte(13)
+	abiTagList      = byte(14)
+	abiTagFixedList = byte(15)
+)
+
+// key tags of the ABI of the execution engine
+const (
+	abiKeyAccount = byte(iota)
+	abiKeyHash
+	abiKeyURef
+	abiKeyLocal
+)
+
+const (
+	abiSizeLength    = 4
+	abiAddressLength = 32
+)
+
+// DeployArgs are the session or payment arguments of a deploy.
+// Supported types are String, U512, Key, Option, List and bytes, which is a list of U8.
+type DeployArgs []*consensus.Deploy_Arg
+
+// NewDeployArgs returns empty deploy arguments
+func NewDeployArgs() DeployArgs {
+	return DeployArgs{}
+}
+
+// NewMethodArgs returns the arguments of a call of the method of a system contract
+func NewMethodArgs(method string) DeployArgs {
+	return NewDeployArgs().Add("", StringValue(method))
+}
+
+// Add returns the arguments with the value appended. The name may be empty.
+func (args DeployArgs) Add(name string, value *state.CLValueInstance) DeployArgs {
+	return append(args, &consensus.Deploy_Arg{Name: name, Value: value})
+}
+
+// SimpleType returns the CLType of a simple type
+func SimpleType(simpleType state.CLType_Simple) *state.CLType {
+	return &state.CLType{Variants: &state.CLType_SimpleType{SimpleType: simpleType}}
+}
+
+// OptionType returns the CLType of an option of inner
+func OptionType(inner *state.CLType) *state.CLType {
+	return &state.CLType{Variants: &state.CLType_OptionType{OptionType: &state.CLType_Option{Inner: inner}}}
+}
+
+// ListType returns the CLType of a list of inner
+func ListType(inner *state.CLType) *state.CLType {
+	return &state.CLType{Variants: &state.CLType_ListType{ListType: &state.CLType_List{Inner: inner}}}
+}
+
+// BytesType returns the CLType of bytes, which are a fixed list of U8
+func BytesType(length int) *state.CLType {
+	return &state.CLType{Variants: &state.CLType_FixedListType{FixedListType: &state.CLType_FixedList{Inner: SimpleType(state.CLType_U8), Len: uint32(length)}}}
+}
+
+// StringValue returns a String value
+func StringValue(value string) *state.CLValueInstance {
+	return &state.CLValueInstance{
+		ClType: SimpleType(state.CLType_STRING),
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_StrValue{StrValue: value}},
+	}
+}
+
+// U8Value returns a U8 value
+func U8Value(value uint8) *state.CLValueInstance {
+	return &state.CLValueInstance{
+		ClType: SimpleType(state.CLType_U8),
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_U8{U8: int32(value)}},
+	}
+}
+
+// U512Value returns a U512 value of a decimal string
+func U512Value(value string) *state.CLValueInstance {
+	return &state.CLValueInstance{
+		ClType: SimpleType(state.CLType_U512),
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_U512{U512: &state.CLValueInstance_U512{Value: value}}},
+	}
+}
+
+// BytesValue returns a bytes value, e.g. an address
+func BytesValue(value []byte) *state.CLValueInstance {
+	return &state.CLValueInstance{
+		ClType: BytesType(len(value)),
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_BytesValue{BytesValue: value}},
+	}
+}
+
+// KeyValue returns a Key value
+func KeyValue(key *state.Key) *state.CLValueInstance {
+	return &state.CLValueInstance{
+		ClType: SimpleType(state.CLType_KEY),
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_Key{Key: key}},
+	}
+}
+
+// SomeValue returns an option which has the value
+func SomeValue(value *state.CLValueInstance) *state.CLValueInstance {
+	return &state.CLValueInstance{
+		ClType: OptionType(value.GetClType()),
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_OptionValue{OptionValue: &state.CLValueInstance_Option{Value: value.GetValue()}}},
+	}
+}
+
+// NoneValue returns an empty option of inner
+func NoneValue(inner *state.CLType) *state.CLValueInstance {
+	return &state.CLValueInstance{
+		ClType: OptionType(inner),
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_OptionValue{OptionValue: &state.CLValueInstance_Option{}}},
+	}
+}
+
+// ListValue returns a list of inner values
+func ListValue(inner *state.CLType, values ...*state.CLValueInstance) *state.CLValueInstance {
+	list := make([]*state.CLValueInstance_Value, len(values))
+	for i, value := range values {
+		list[i] = value.GetValue()
+	}
+	return &state.CLValueInstance{
+		ClType: ListType(inner),
+		Value:  &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_ListValue{ListValue: &state.CLValueInstance_List{Values: list}}},
+	}
+}
+
+// AccountKey returns the key of an account
+func AccountKey(address []byte) *state.Key {
+	return &state.Key{Value: &state.Key_Address_{Address: &state.Key_Address{Account: address}}}
+}
+
+// HashKey returns the key of a contract stored by hash
+func HashKey(hash []byte) *state.Key {
+	return &state.Key{Value: &state.Key_Hash_{Hash: &state.Key_Hash{Hash: hash}}}
+}
+
+// URefKey returns the key of a contract stored by uref, without access rights
+func URefKey(uref []byte) *state.Key {
+	return &state.Key{Value: &state.Key_Uref{Uref: &state.Key_URef{Uref: uref, AccessRights: state.Key_URef_NONE}}}
+}
+
+// ContractKey returns the key of the bech32 address of a contract stored by hash or uref
+func ContractKey(address string) (*state.Key, error) {
+	switch {
+	case strings.HasPrefix(address, sdk.Bech32PrefixContractURef):
+		uref, err := sdk.ContractUrefAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		return URefKey(uref.Bytes()), nil
+	case strings.HasPrefix(address, sdk.Bech32PrefixContractHash):
+		hash, err := sdk.ContractHashAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		return HashKey(hash.Bytes()), nil
+	default:
+		return nil, fmt.Errorf("invalid contract address: %s", address)
+	}
+}
+
+//______________________________________________________________________
+// ABI
+
+// Encode returns the arguments encoded with the ABI of the execution engine
+func (args DeployArgs) Encode() ([]byte, error) {
+	res := make([]byte, abiSizeLength)
+	binary.LittleEndian.PutUint32(res, uint32(len(args)))
+
+	for i, arg := range args {
+		clType := arg.GetValue().GetClType()
+		if clType == nil {
+			clType = inferType(arg.GetValue().GetValue())
+		}
+
+		value, tags, err := encodeValue(clType, arg.GetValue().GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %s", i, err.Error())
+		}
+
+		size := make([]byte, abiSizeLength)
+		binary.LittleEndian.PutUint32(size, uint32(len(value)))
+		res = append(res, size...)
+		res = append(res, value...)
+		res = append(res, tags...)
+	}
+
+	return res, nil
+}
+
+// inferType returns the type of a value which has no declared type, or nil if it is unknown
+func inferType(value *state.CLValueInstance_Value) *state.CLType {
+	switch value.GetValue().(type) {
+	case *state.CLValueInstance_Value_U8:
+		return SimpleType(state.CLType_U8)
+	case *state.CLValueInstance_Value_U512:
+		return SimpleType(state.CLType_U512)
+	case *state.CLValueInstance_Value_StrValue:
+		return SimpleType(state.CLType_STRING)
+	case *state.CLValueInstance_Value_Key:
+		return SimpleType(state.CLType_KEY)
+	case *state.CLValueInstance_Value_BytesValue:
+		return BytesType(len(value.GetBytesValue()))
+	case *state.CLValueInstance_Value_OptionValue:
+		if inner := inferType(value.GetOptionValue().GetValue()); inner != nil {
+			return OptionType(inner)
+		}
+	case *state.CLValueInstance_Value_ListValue:
+		if values := value.GetListValue().GetValues(); len(values) > 0 {
+			if inner := inferType(values[0]); inner != nil {
+				return ListType(inner)
+			}
+		}
+	}
+	return nil
+}
+
+// encodeValue returns the serialized value and the type tags of the value of clType
+func encodeValue(clType *state.CLType, value *state.CLValueInstance_Value) ([]byte, []byte, error) {
+	// bytes are a fixed list of U8 whatever the declared list type is, as the engine expects for addresses
+	if bytesValue, ok := value.GetValue().(*state.CLValueInstance_Value_BytesValue); ok {
+		length := make([]byte, abiSizeLength)
+		binary.LittleEndian.PutUint32(length, uint32(len(bytesValue.BytesValue)))
+		return bytesValue.BytesValue, append([]byte{abiTagFixedList, abiTagU8}, length...), nil
+	}
+
+	switch clType.GetVariants().(type) {
+	case *state.CLType_SimpleType:
+		return encodeSimpleValue(clType.GetSimpleType(), value)
+
+	case *state.CLType_OptionType:
+		option, ok := value.GetValue().(*state.CLValueInstance_Value_OptionValue)
+		if !ok {
+			return nil, nil, fmt.Errorf("option value expected")
+		}
+		_, tags, err := encodeType(clType.GetOptionType().GetInner())
+		if err != nil {
+			return nil, nil, err
+		}
+		if option.OptionValue.GetValue() == nil {
+			return []byte{0}, append([]byte{abiTagOption}, tags...), nil
+		}
+		inner, innerTags, err := encodeValue(clType.GetOptionType().GetInner(), option.OptionValue.GetValue())
+		if err != nil {
+			return nil, nil, err
+		}
+		return append([]byte{1}, inner...), append([]byte{abiTagOption}, innerTags...), nil
+
+	case *state.CLType_ListType:
+		list, ok := value.GetValue().(*state.CLValueInstance_Value_ListValue)
+		if !ok {
+			return nil, nil, fmt.Errorf("list value expected")
+		}
+		_, tags, err := encodeType(clType.GetListType().GetInner())
+		if err != nil {
+			return nil, nil, err
+		}
+		res := make([]byte, abiSizeLength)
+		binary.LittleEndian.PutUint32(res, uint32(len(list.ListValue.GetValues())))
+		for _, entry := range list.ListValue.GetValues() {
+			bz, _, err := encodeValue(clType.GetListType().GetInner(), entry)
+			if err != nil {
+				return nil, nil, err
+			}
+			res = append(res, bz...)
+		}
+		return res, append([]byte{abiTagList}, tags...), nil
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported type: %v", clType)
+	}
+}
+
+// encodeType returns the type tags of clType
+func encodeType(clType *state.CLType) ([]byte, []byte, error) {
+	switch clType.GetVariants().(type) {
+	case *state.CLType_SimpleType:
+		switch clType.GetSimpleType() {
+		case state.CLType_U8, state.CLType_U512, state.CLType_STRING, state.CLType_KEY:
+			return nil, []byte{byte(clType.GetSimpleType())}, nil
+		}
+	case *state.CLType_OptionType:
+		_, tags, err := encodeType(clType.GetOptionType().GetInner())
+		return nil, append([]byte{abiTagOption}, tags...), err
+	case *state.CLType_ListType:
+		_, tags, err := encodeType(clType.GetListType().GetInner())
+		return nil, append([]byte{abiTagList}, tags...), err
+	}
+	return nil, nil, fmt.Errorf("unsupported type: %v", clType)
+}
+
+func encodeSimpleValue(simpleType state.CLType_Simple, value *state.CLValueInstance_Value) ([]byte, []byte, error) {
+	switch simpleType {
+	case state.CLType_U8:
+		u8, ok := value.GetValue().(*state.CLValueInstance_Value_U8)
+		if !ok || u8.U8 < 0 || u8.U8 > 255 {
+			return nil, nil, fmt.Errorf("U8 value expected")
+		}
+		return []byte{byte(u8.U8)}, []byte{abiTagU8}, nil
+
+	case state.CLType_U512:
+		u512, ok := value.GetValue().(*state.CLValueInstance_Value_U512)
+		if !ok {
+			return nil, nil, fmt.Errorf("U512 value expected")
+		}
+		amount, ok := new(big.Int).SetString(u512.U512.GetValue(), 10)
+		if !ok || amount.Sign() < 0 || amount.BitLen() > 512 {
+			return nil, nil, fmt.Errorf("invalid U512 value: %s", u512.U512.GetValue())
+		}
+		bz := amount.Bytes()
+		// little endian
+		for i, j := 0, len(bz)-1; i < j; i, j = i+1, j-1 {
+			bz[i], bz[j] = bz[j], bz[i]
+		}
+		return append([]byte{byte(len(bz))}, bz...), []byte{abiTagU512}, nil
+
+	case state.CLType_STRING:
+		str, ok := value.GetValue().(*state.CLValueInstance_Value_StrValue)
+		if !ok {
+			return nil, nil, fmt.Errorf("String value expected")
+		}
+		res := make([]byte, abiSizeLength)
+		binary.LittleEndian.PutUint32(res, uint32(len(str.StrValue)))
+		return append(res, str.StrValue...), []byte{abiTagString}, nil
+
+	case state.CLType_KEY:
+		key, ok := value.GetValue().(*state.CLValueInstance_Value_Key)
+		if !ok {
+			return nil, nil, fmt.Errorf("Key value expected")
+		}
+		bz, err := encodeKey(key.Key)
+		return bz, []byte{abiTagKey}, err
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported type: %s", simpleType)
+	}
+}
+
+func encodeKey(key *state.Key) ([]byte, error) {
+	var tag byte
+	var address []byte
+	var suffix []byte
+	switch key.GetValue().(type) {
+	case *state.Key_Address_:
+		tag, address = abiKeyAccount, key.GetAddress().GetAccount()
+	case *state.Key_Hash_:
+		tag, address = abiKeyHash, key.GetHash().GetHash()
+	case *state.Key_Uref:
+		tag, address = abiKeyURef, key.GetUref().GetUref()
+		suffix = []byte{byte(key.GetUref().GetAccessRights())}
+	case *state.Key_Local_:
+		tag, address = abiKeyLocal, key.GetLocal().GetHash()
+	default:
+		return nil, fmt.Errorf("invalid key")
+	}
+	if len(address) != abiAddressLength {
+		return nil, fmt.Errorf("key length must be %d, but %d", abiAddressLength, len(address))
+	}
+
+	res := append([]byte{tag}, address...)
+	return append(res, suffix...), nil
+}
+
+// DecodeDeployArgs decodes arguments encoded with the ABI of the execution engine
+func DecodeDeployArgs(bz []byte) (DeployArgs, error) {
+	r := &abiReader{bz: bz}
+	count, err := r.size()
+	if err != nil {
+		return nil, err
+	}
+
+	args := NewDeployArgs()
+	for i := 0; i < count; i++ {
+		size, err := r.size()
+		if err != nil {
+			return nil, err
+		}
+		value, err := r.next(size)
+		if err != nil {
+			return nil, err
+		}
+		clType, err := r.clType()
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %s", i, err.Error())
+		}
+
+		vr := &abiReader{bz: value}
+		decoded, err := vr.value(clType)
+		if err == nil && len(vr.bz) > 0 {
+			err = fmt.Errorf("%d trailing bytes", len(vr.bz))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %s", i, err.Error())
+		}
+		args = args.Add("", &state.CLValueInstance{ClType: clType, Value: decoded})
+	}
+	if len(r.bz) > 0 {
+		return nil, fmt.Errorf("%d trailing bytes", len(r.bz))
+	}
+
+	return args, nil
+}
+
+type abiReader struct {
+	bz []byte
+}
+
+func (r *abiReader) next(n int) ([]byte, error) {
+	if n < 0 || len(r.bz) < n {
+		return nil, fmt.Errorf("unexpected end of arguments")
+	}
+	res := r.bz[:n]
+	r.bz = r.bz[n:]
+	return res, nil
+}
+
+func (r *abiReader) size() (int, error) {
+	bz, err := r.next(abiSizeLength)
+	if err != nil {
+		return 0, err
+	}
+	return int(binary.LittleEndian.Uint32(bz)), nil
+}
+
+func (r *abiReader) clType() (*state.CLType, error) {
+	tag, err := r.next(1)
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag[0] {
+	case abiTagU8, abiTagU512, abiTagString, abiTagKey:
+		return SimpleType(state.CLType_Simple(tag[0])), nil
+	case abiTagOption:
+		inner, err := r.clType()
+		if err != nil {
+			return nil, err
+		}
+		return OptionType(inner), nil
+	case abiTagList:
+		inner, err := r.clType()
+		if err != nil {
+			return nil, err
+		}
+		return ListType(inner), nil
+	case abiTagFixedList:
+		inner, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		if inner[0] != abiTagU8 {
+			return nil, fmt.Errorf("unsupported fixed list of %d", inner[0])
+		}
+		length, err := r.size()
+		if err != nil {
+			return nil, err
+		}
+		return BytesType(length), nil
+	default:
+		return nil, fmt.Errorf("unsupported type tag %d", tag[0])
+	}
+}
+
+func (r *abiReader) value(clType *state.CLType) (*state.CLValueInstance_Value, error) {
+	switch clType.GetVariants().(type) {
+	case *state.CLType_FixedListType:
+		bz, err := r.next(int(clType.GetFixedListType().GetLen()))
+		if err != nil {
+			return nil, err
+		}
+		return &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_BytesValue{BytesValue: bz}}, nil
+
+	case *state.CLType_OptionType:
+		some, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		option := &state.CLValueInstance_Option{}
+		if some[0] == 1 {
+			if option.Value, err = r.value(clType.GetOptionType().GetInner()); err != nil {
+				return nil, err
+			}
+		}
+		return &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_OptionValue{OptionValue: option}}, nil
+
+	case *state.CLType_ListType:
+		count, err := r.size()
+		if err != nil {
+			return nil, err
+		}
+		list := &state.CLValueInstance_List{}
+		for i := 0; i < count; i++ {
+			entry, err := r.value(clType.GetListType().GetInner())
+			if err != nil {
+				return nil, err
+			}
+			list.Values = append(list.Values, entry)
+		}
+		return &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_ListValue{ListValue: list}}, nil
+	}
+
+	switch clType.GetSimpleType() {
+	case state.CLType_U8:
+		bz, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		return &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_U8{U8: int32(bz[0])}}, nil
+
+	case state.CLType_U512:
+		length, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := r.next(int(length[0]))
+		if err != nil {
+			return nil, err
+		}
+		be := make([]byte, len(bz))
+		for i := range bz {
+			be[len(bz)-1-i] = bz[i]
+		}
+		amount := new(big.Int).SetBytes(be)
+		return &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_U512{U512: &state.CLValueInstance_U512{Value: amount.String()}}}, nil
+
+	case state.CLType_STRING:
+		length, err := r.size()
+		if err != nil {
+			return nil, err
+		}
+		bz, err := r.next(length)
+		if err != nil {
+			return nil, err
+		}
+		return &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_StrValue{StrValue: string(bz)}}, nil
+
+	default:
+		tag, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		address, err := r.next(abiAddressLength)
+		if err != nil {
+			return nil, err
+		}
+
+		var key *state.Key
+		switch tag[0] {
+		case abiKeyAccount:
+			key = AccountKey(address)
+		case abiKeyHash:
+			key = HashKey(address)
+		case abiKeyURef:
+			accessRights, err := r.next(1)
+			if err != nil {
+				return nil, err
+			}
+			key = URefKey(address)
+			key.GetUref().AccessRights = state.Key_URef_AccessRights(accessRights[0])
+		case abiKeyLocal:
+			key = &state.Key{Value: &state.Key_Local_{Local: &state.Key_Local{Hash: address}}}
+		default:
+			return nil, fmt.Errorf("invalid key tag %d", tag[0])
+		}
+		return &state.CLValueInstance_Value{Value: &state.CLValueInstance_Value_Key{Key: key}}, nil
+	}
+}
+
+//______________________________________________________________________
+// JSON
+
+// ToJSON returns the arguments in the json format of the execution engine
+func (args DeployArgs) ToJSON() (string, error) {
+	return util.DeployArgsToJsonString(args)
+}
+
+// DeployArgsFromJSON parses arguments in the json format of the execution engine.
+// Contract and account addresses may be given in bech32, and the accounts are returned.
+func DeployArgsFromJSON(str string) (DeployArgs, []sdk.AccAddress, error) {
+	replaced, addrList, err := ReplaceFromBech32ToHex(str)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args, err := util.JsonStringToDeployArgs(replaced)
+	if err != nil {
+		return nil, nil, err
+	}
+	return DeployArgs(args), addrList, nil
+}
+
+// ReplaceFromBech32ToHex replaces the bech32 addresses in json arguments with their base64 bytes.
+// It returns the account addresses which are given as arguments.
+func ReplaceFromBech32ToHex(valueStr string) (string, []sdk.AccAddress, error) {
+	res := valueStr
+	addrList := []sdk.AccAddress{}
+
+	r := regexp.MustCompile(fmt.Sprintf(`\"hash\":\{\"hash\":\"(%s[a-zA-Z0-9+/]+)\"`, sdk.Bech32PrefixContractHash))
+	for _, matchedGroup := range r.FindAllStringSubmatch(valueStr, -1) {
+		hashStr := matchedGroup[1]
+		hashaddr, err := sdk.ContractHashAddressFromBech32(hashStr)
+		if err != nil {
+			return valueStr, []sdk.AccAddress{}, err
+		}
+		hashaddrhex := base64.StdEncoding.EncodeToString(hashaddr.Bytes())
+
+		filterHashStr := `"hash":{"hash":"` + hashStr
+		replaceStr := `"hash":{"hash":"` + hashaddrhex
+		res = strings.Replace(res, filterHashStr, replaceStr, -1)
+	}
+
+	r = regexp.MustCompile(fmt.Sprintf(`\"uref\":\{\"uref\":\"(%s[a-zA-Z0-9+/]+)\"`, sdk.Bech32PrefixContractURef))
+	for _, matchedGroup := range r.FindAllStringSubmatch(valueStr, -1) {
+		urefStr := matchedGroup[1]
+		urefaddr, err := sdk.ContractUrefAddressFromBech32(urefStr)
+		if err != nil {
+			return valueStr, []sdk.AccAddress{}, err
+		}
+		urefaddrhex := base64.StdEncoding.EncodeToString(urefaddr.Bytes())
+
+		filterUrefStr := `"uref":{"uref":"` + urefStr
+		replaceStr := `"uref":{"uref":"` + urefaddrhex
+		res = strings.Replace(res, filterUrefStr, replaceStr, -1)
+	}
+
+	r = regexp.MustCompile(fmt.Sprintf(`{\"name\":\"address\",\"value\":{\"cl_type\":\{\"list\_type\":\{\"inner\":\{\"simple_type\":\"U8\"\}\}\},\"value\":\{\"bytes\_value\":\"(%s[a-zA-Z0-9+/]+)\"\}\}\}`, sdk.Bech32PrefixAccAddr))
+	for _, matchedGroup := range r.FindAllStringSubmatch(valueStr, -1) {
+		accountStr := matchedGroup[1]
+		accountAddr, err := sdk.AccAddressFromBech32(accountStr)
+		if err != nil {
+			return valueStr, []sdk.AccAddress{}, err
+		}
+		addrList = append(addrList, accountAddr)
+		accountHex := base64.StdEncoding.EncodeToString(accountAddr.Bytes())
+
+		filterAccountStr := `{"name":"address","value":{"cl_type":{"list_type":{"inner":{"simple_type":"U8"}}},"value":{"bytes_value":"` + accountStr
+		replaceStr := `{"name":"address","value":{"cl_type":{"list_type":{"inner":{"simple_type":"U8"}}},"value":{"bytes_value":"` + accountHex
+		res = strings.Replace(res, filterAccountStr, replaceStr, -1)
+	}
+
+	return res, addrList, nil
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+func TestDeployArgsEncodeCompatible(t *testing.T) {
+	address := bytes.Repeat([]byte{1}, 32)
+	cases := []struct {
+		name string
+		args DeployArgs
+	}{
+		{"transfer", NewMethodArgs(TransferMethodName).Add("", BytesValue(address)).Add("", U512Value("1000000000"))},
+		{"payment", NewMethodArgs(PaymentMethodName).Add("", U512Value("0"))},
+		{"vote by hash", NewMethodArgs(VoteMethodName).Add("", KeyValue(HashKey(address))).Add("", U512Value("12345678901234567890"))},
+		{"vote by uref", NewMethodArgs(VoteMethodName).Add("", KeyValue(URefKey(address))).Add("", U512Value("1"))},
+		{"unbond", NewDeployArgs().Add("method", StringValue(UnbondMethodName)).Add("amount", SomeValue(U512Value("100")))},
+		{"list", NewDeployArgs().Add("", ListValue(SimpleType(state.CLType_STRING), StringValue("a"), StringValue("bc")))},
+	}
+
+	for _, tc := range cases {
+		expected, err := util.AbiDeployArgsTobytes([]*consensus.Deploy_Arg(tc.args))
+		require.NoError(t, err, tc.name)
+		res, err := tc.args.Encode()
+		require.NoError(t, err, tc.name)
+		require.Equal(t, expected, res, tc.name)
+	}
+}
+
+func TestDeployArgsRoundTrip(t *testing.T) {
+	address := bytes.Repeat([]byte{2}, 32)
+	args := NewDeployArgs().
+		Add("", StringValue("method")).
+		Add("", U512Value("340282366920938463463374607431768211456")).
+		Add("", BytesValue(address)).
+		Add("", KeyValue(AccountKey(address))).
+		Add("", KeyValue(HashKey(address))).
+		Add("", KeyValue(URefKey(address))).
+		Add("", SomeValue(U512Value("7"))).
+		Add("", NoneValue(SimpleType(state.CLType_U512))).
+		Add("", ListValue(SimpleType(state.CLType_KEY), KeyValue(HashKey(address)), KeyValue(URefKey(address)))).
+		Add("", ListValue(SimpleType(state.CLType_U512)))
+
+	bz, err := args.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodeDeployArgs(bz)
+	require.NoError(t, err)
+	require.Equal(t, len(args), len(decoded))
+	for i := range args {
+		require.Equal(t, args[i].GetValue().String(), decoded[i].GetValue().String(), "argument %d", i)
+	}
+
+	reencoded, err := decoded.Encode()
+	require.NoError(t, err)
+	require.Equal(t, bz, reencoded)
+}
+
+func TestDeployArgsEncodeInvalid(t *testing.T) {
+	cases := []struct {
+		name string
+		args DeployArgs
+	}{
+		{"invalid U512", NewDeployArgs().Add("", U512Value("-1"))},
+		{"not a number", NewDeployArgs().Add("", U512Value("abc"))},
+		{"short key", NewDeployArgs().Add("", KeyValue(HashKey([]byte{1, 2, 3})))},
+		{"type mismatch", NewDeployArgs().Add("", &state.CLValueInstance{ClType: SimpleType(state.CLType_U512), Value: StringValue("a").GetValue()})},
+	}
+
+	for _, tc := range cases {
+		_, err := tc.args.Encode()
+		require.Error(t, err, tc.name)
+	}
+
+	_, err := DecodeDeployArgs([]byte{1, 0, 0, 0, 5, 0, 0, 0})
+	require.Error(t, err)
+}
+
+func TestContractKey(t *testing.T) {
+	hash := bytes.Repeat([]byte{3}, 32)
+
+	key, err := ContractKey(sdk.ContractHashAddress(hash).String())
+	require.NoError(t, err)
+	require.Equal(t, HashKey(hash), key)
+
+	key, err = ContractKey(sdk.ContractUrefAddress(hash).String())
+	require.NoError(t, err)
+	require.Equal(t, URefKey(hash), key)
+
+	_, err = ContractKey(sdk.AccAddress(hash).String())
+	require.Error(t, err)
+}
+
+func TestDeployArgsFromJSON(t *testing.T) {
+	address := bytes.Repeat([]byte{4}, 32)
+	args := NewMethodArgs(VoteMethodName).Add("", KeyValue(HashKey(address)))
+	str, err := args.ToJSON()
+	require.NoError(t, err)
+
+	parsed, addrList, err := DeployArgsFromJSON(str)
+	require.NoError(t, err)
+	require.Empty(t, addrList)
+
+	expected, err := args.Encode()
+	require.NoError(t, err)
+	res, err := parsed.Encode()
+	require.NoError(t, err)
+	require.Equal(t, expected, res)
+}
@@ -18,8 +18,11 @@ func RegisterCodec(cdc *codec.Codec) {
 
 	cdc.RegisterConcrete(MsgCreateValidator{}, "executionengine/CreateValidator", nil)
 	cdc.RegisterConcrete(MsgEditValidator{}, "executionengine/EditValidator", nil)
+	cdc.RegisterConcrete(MsgJail{}, "executionengine/Jail", nil)
+	cdc.RegisterConcrete(MsgUnjail{}, "executionengine/Unjail", nil)
 	cdc.RegisterConcrete(MsgExecute{}, "executionengine/Execute", nil)
 	cdc.RegisterConcrete(MsgTransfer{}, "executionengine/Transfer", nil)
+	cdc.RegisterConcrete(MsgMultiTransfer{}, "executionengine/MultiTransfer", nil)
 	cdc.RegisterConcrete(MsgBond{}, "executionengine/Bond", nil)
 	cdc.RegisterConcrete(MsgUnBond{}, "executionengine/UnBond", nil)
 	cdc.RegisterConcrete(MsgDelegate{}, "executionengine/Delegate", nil)
@@ -28,6 +31,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgVote{}, "executionengine/Vote", nil)
 	cdc.RegisterConcrete(MsgUnvote{}, "executionengine/Unvote", nil)
 	cdc.RegisterConcrete(MsgClaim{}, "executionengine/Claim", nil)
+	cdc.RegisterConcrete(MsgDeployContract{}, "executionengine/DeployContract", nil)
+	cdc.RegisterConcrete(MsgAddAssociatedKey{}, "executionengine/AddAssociatedKey", nil)
+	cdc.RegisterConcrete(MsgRemoveAssociatedKey{}, "executionengine/RemoveAssociatedKey", nil)
+	cdc.RegisterConcrete(MsgSetActionThresholds{}, "executionengine/SetActionThresholds", nil)
+	cdc.RegisterConcrete(EngineUpgradeProposal{}, "friday/EngineUpgradeProposal", nil)
 	cdc.RegisterConcrete(ContractHashAddress{}, "types/ContractHashAddress", nil)
 	cdc.RegisterConcrete(ContractUrefAddress{}, "types/ContractUrefAddress", nil)
 }
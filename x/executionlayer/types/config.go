@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// default connection settings of the execution engine
+var (
+	DefaultEngineAddress         = os.ExpandEnv("$HOME/.casperlabs/.casper-node.sock")
+	DefaultEngineDialTimeout     = 5 * time.Second
+	DefaultEngineCallTimeout     = 60 * time.Second
+	DefaultEngineRetryBackoff    = 1 * time.Second
+	DefaultEngineMaxRetryBackoff = 30 * time.Second
+)
+
+// EngineConfig defines how the execution layer reaches the execution engine
+type EngineConfig struct {
+	// Address of the engine. It is a unix socket path, "unix://<path>",
+	// "tcp://<host>:<port>" or MockEngineAddress.
+	Address string `mapstructure:"ee-address"`
+
+	// DialTimeout bounds a single connection attempt to the engine.
+	DialTimeout time.Duration `mapstructure:"ee-dial-timeout"`
+
+	// CallTimeout is the deadline of a single call. Zero means no deadline.
+	CallTimeout time.Duration `mapstructure:"ee-call-timeout"`
+
+	// MaxRetries is the number of retries while the engine is unavailable.
+	// Zero retries forever, which stalls block processing until the engine is back.
+	MaxRetries int `mapstructure:"ee-max-retries"`
+
+	// RetryBackoff is the first delay between retries. It doubles on every
+	// retry up to MaxRetryBackoff.
+	RetryBackoff    time.Duration `mapstructure:"ee-retry-backoff"`
+	MaxRetryBackoff time.Duration `mapstructure:"ee-max-retry-backoff"`
+}
+
+// NewEngineConfig returns the default configuration for the given address
+func NewEngineConfig(address string) EngineConfig {
+	return EngineConfig{
+		Address:         address,
+		DialTimeout:     DefaultEngineDialTimeout,
+		CallTimeout:     DefaultEngineCallTimeout,
+		MaxRetries:      0,
+		RetryBackoff:    DefaultEngineRetryBackoff,
+		MaxRetryBackoff: DefaultEngineMaxRetryBackoff,
+	}
+}
+
+// DefaultEngineConfig returns the default configuration of the execution engine connection
+func DefaultEngineConfig() EngineConfig {
+	return NewEngineConfig(DefaultEngineAddress)
+}
+
+// Endpoint splits the address into the network and the address to dial
+func (c EngineConfig) Endpoint() (network string, address string, err error) {
+	switch {
+	case c.Address == "":
+		return "", "", fmt.Errorf("execution engine address is empty")
+	case strings.HasPrefix(c.Address, "tcp://"):
+		network, address = "tcp", strings.TrimPrefix(c.Address, "tcp://")
+	case strings.HasPrefix(c.Address, "unix://"):
+		network, address = "unix", strings.TrimPrefix(c.Address, "unix://")
+	case strings.Contains(c.Address, "://"):
+		return "", "", fmt.Errorf("unsupported execution engine address: %s", c.Address)
+	default:
+		network, address = "unix", c.Address
+	}
+
+	if address == "" {
+		return "", "", fmt.Errorf("execution engine address is empty: %s", c.Address)
+	}
+	return network, address, nil
+}
+
+// ValidateBasic validates the configuration
+func (c EngineConfig) ValidateBasic() error {
+	if c.Address != MockEngineAddress {
+		if _, _, err := c.Endpoint(); err != nil {
+			return err
+		}
+	}
+	if c.DialTimeout <= 0 {
+		return fmt.Errorf("execution engine dial timeout must be positive: %s", c.DialTimeout)
+	}
+	if c.CallTimeout < 0 {
+		return fmt.Errorf("execution engine call timeout must not be negative: %s", c.CallTimeout)
+	}
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("execution engine max retries must not be negative: %d", c.MaxRetries)
+	}
+	if c.RetryBackoff <= 0 || c.MaxRetryBackoff < c.RetryBackoff {
+		return fmt.Errorf("invalid execution engine retry backoff: %s (max %s)", c.RetryBackoff, c.MaxRetryBackoff)
+	}
+	return nil
+}
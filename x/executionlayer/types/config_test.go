@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngineConfigEndpoint(t *testing.T) {
+	tests := []struct {
+		address string
+		network string
+		target  string
+		valid   bool
+	}{
+		{"/tmp/.casper-node.sock", "unix", "/tmp/.casper-node.sock", true},
+		{"unix:///tmp/.casper-node.sock", "unix", "/tmp/.casper-node.sock", true},
+		{"tcp://127.0.0.1:40401", "tcp", "127.0.0.1:40401", true},
+		{"http://127.0.0.1:40401", "", "", false},
+		{"tcp://", "", "", false},
+		{"", "", "", false},
+	}
+
+	for _, tc := range tests {
+		network, target, err := NewEngineConfig(tc.address).Endpoint()
+		if !tc.valid {
+			require.Error(t, err, tc.address)
+			continue
+		}
+		require.NoError(t, err, tc.address)
+		require.Equal(t, tc.network, network)
+		require.Equal(t, tc.target, target)
+	}
+}
+
+func TestEngineConfigValidateBasic(t *testing.T) {
+	require.NoError(t, DefaultEngineConfig().ValidateBasic())
+	require.NoError(t, NewEngineConfig(MockEngineAddress).ValidateBasic())
+
+	config := DefaultEngineConfig()
+	config.DialTimeout = 0
+	require.Error(t, config.ValidateBasic())
+
+	config = DefaultEngineConfig()
+	config.MaxRetries = -1
+	require.Error(t, config.ValidateBasic())
+
+	config = DefaultEngineConfig()
+	config.MaxRetryBackoff = config.RetryBackoff - time.Millisecond
+	require.Error(t, config.ValidateBasic())
+}
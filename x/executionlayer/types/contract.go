@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+const (
+	// MaxContractNameLength is the longest name of a registered contract
+	MaxContractNameLength = 64
+	// MaxContractMetadataLength is the longest metadata of a registered contract
+	MaxContractMetadataLength = 1024
+)
+
+var contractNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-.]*$`)
+
+// RegisteredContract is an entry of the contract registry.
+// A contract is registered under its name by the account which deployed it.
+type RegisteredContract struct {
+	Name            string         `json:"name" yaml:"name"`
+	Deployer        sdk.AccAddress `json:"deployer" yaml:"deployer"`
+	CodeHash        string         `json:"code_hash" yaml:"code_hash"`
+	ContractAddress string         `json:"contract_address" yaml:"contract_address"`
+	Height          int64          `json:"height" yaml:"height"`
+	Metadata        string         `json:"metadata" yaml:"metadata"`
+}
+
+// NewRegisteredContract returns an entry of the contract registry
+func NewRegisteredContract(name string, deployer sdk.AccAddress, codeHash string, contractAddress string, height int64, metadata string) RegisteredContract {
+	return RegisteredContract{
+		Name:            name,
+		Deployer:        deployer,
+		CodeHash:        codeHash,
+		ContractAddress: contractAddress,
+		Height:          height,
+		Metadata:        metadata,
+	}
+}
+
+// implement fmt.Stringer
+func (c RegisteredContract) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Name:             %s
+Deployer:         %s
+Code hash:        %s
+Contract address: %s
+Height:           %d
+Metadata:         %s`, c.Name, c.Deployer, c.CodeHash, c.ContractAddress, c.Height, c.Metadata))
+}
+
+// RegisteredContracts is a list of registered contracts
+type RegisteredContracts []RegisteredContract
+
+// implement fmt.Stringer
+func (c RegisteredContracts) String() string {
+	out := []string{}
+	for _, contract := range c {
+		out = append(out, contract.String())
+	}
+	return strings.Join(out, "\n\n")
+}
+
+// ValidateContractName checks the name of a contract to register.
+// Names are lower case alphanumerics, '_', '-' and '.', and start with an alphanumeric.
+func ValidateContractName(name string) sdk.Error {
+	if len(name) == 0 || len(name) > MaxContractNameLength {
+		return ErrInvalidContractName(DefaultCodespace, name)
+	}
+	if !contractNameRegexp.MatchString(name) {
+		return ErrInvalidContractName(DefaultCodespace, name)
+	}
+	return nil
+}
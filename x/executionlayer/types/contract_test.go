@@ -0,0 +1,32 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+func TestValidateContractName(t *testing.T) {
+	for _, name := range []string{"counter", "erc20-token", "a.b_c", "0x1"} {
+		require.Nil(t, ValidateContractName(name), name)
+	}
+	for _, name := range []string{"", "Counter", "-counter", "my contract", strings.Repeat("a", MaxContractNameLength+1)} {
+		err := ValidateContractName(name)
+		require.NotNil(t, err, name)
+		require.Equal(t, CodeInvalidContractName, err.Code())
+	}
+}
+
+func TestMsgDeployContractValidateBasic(t *testing.T) {
+	deployer := sdk.AccAddress(make([]byte, sdk.AddrLen))
+	code := []byte{0x00, 0x61, 0x73, 0x6d}
+
+	require.Nil(t, NewMsgDeployContract(deployer, "counter", code, "", "", BASIC_FEE).ValidateBasic())
+	require.NotNil(t, NewMsgDeployContract(nil, "counter", code, "", "", BASIC_FEE).ValidateBasic())
+	require.NotNil(t, NewMsgDeployContract(deployer, "Counter", code, "", "", BASIC_FEE).ValidateBasic())
+	require.NotNil(t, NewMsgDeployContract(deployer, "counter", nil, "", "", BASIC_FEE).ValidateBasic())
+	require.NotNil(t, NewMsgDeployContract(deployer, "counter", code, "", strings.Repeat("a", MaxContractMetadataLength+1), BASIC_FEE).ValidateBasic())
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DeployHeader is the optional header of a deploy.
+// A deploy expires when its time to live passes from its timestamp,
+// and it is executed only after all of its dependencies are executed.
+type DeployHeader struct {
+	Timestamp    int64    `json:"timestamp" yaml:"timestamp"` // unix time in milliseconds
+	TTLMillis    uint32   `json:"ttl_millis" yaml:"ttl_millis"`
+	Dependencies []string `json:"dependencies" yaml:"dependencies"` // hex encoded deploy hashes
+}
+
+// NewDeployHeader returns a new deploy header
+func NewDeployHeader(timestamp time.Time, ttl time.Duration, dependencies []string) DeployHeader {
+	return DeployHeader{
+		Timestamp:    timestamp.UnixNano() / int64(time.Millisecond),
+		TTLMillis:    uint32(ttl / time.Millisecond),
+		Dependencies: dependencies,
+	}
+}
+
+// Validate runs stateless checks on the deploy header
+func (h DeployHeader) Validate() error {
+	if h.Timestamp <= 0 {
+		return fmt.Errorf("timestamp must be positive: %d", h.Timestamp)
+	}
+	for _, dependency := range h.Dependencies {
+		if hash, err := hex.DecodeString(dependency); err != nil || len(hash) != 32 {
+			return fmt.Errorf("invalid dependency deploy hash: %s", dependency)
+		}
+	}
+	return nil
+}
+
+// Expired returns whether the time to live of the deploy passed at blockTime.
+// A deploy without the time to live does not expire.
+func (h DeployHeader) Expired(blockTime time.Time) bool {
+	if h.TTLMillis == 0 {
+		return false
+	}
+	return blockTime.UnixNano()/int64(time.Millisecond) > h.Timestamp+int64(h.TTLMillis)
+}
+
+// implement fmt.Stringer
+func (h DeployHeader) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Timestamp:    %d
+TTL millis:   %d
+Dependencies: %s`, h.Timestamp, h.TTLMillis, strings.Join(h.Dependencies, ", ")))
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/tendermint/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployHeader(t *testing.T) {
+	timestamp := time.Unix(1583712000, 0)
+	header := NewDeployHeader(timestamp, time.Minute, []string{"0101010101010101010101010101010101010101010101010101010101010101"})
+	require.Equal(t, int64(1583712000000), header.Timestamp)
+	require.Equal(t, uint32(60000), header.TTLMillis)
+	require.NoError(t, header.Validate())
+
+	require.False(t, header.Expired(timestamp.Add(time.Minute)))
+	require.True(t, header.Expired(timestamp.Add(time.Minute+time.Millisecond)))
+
+	// a deploy without the time to live does not expire
+	header.TTLMillis = 0
+	require.False(t, header.Expired(timestamp.Add(24*time.Hour)))
+
+	header.Dependencies = []string{"0101"}
+	require.Error(t, header.Validate())
+	header.Dependencies = nil
+	header.Timestamp = 0
+	require.Error(t, header.Validate())
+}
+
+func TestMsgCreateValidatorHeaderJSON(t *testing.T) {
+	header := NewDeployHeader(time.Unix(1583712000, 0), time.Minute, nil)
+	msg := NewMsgCreateValidator("", sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		ed25519.GenPrivKey().PubKey(), Description{Moniker: "moniker"}, sdk.ZeroDec(), "100")
+	msg.Header = &header
+
+	var res MsgCreateValidator
+	require.NoError(t, ModuleCdc.UnmarshalJSON(ModuleCdc.MustMarshalJSON(msg), &res))
+	require.Equal(t, msg.Header, res.Header)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/hdac-io/friday/types"
 )
@@ -16,10 +17,46 @@ const (
 	CodeInvalidValidator           sdk.CodeType = 201
 	CodeInvalidDelegation          sdk.CodeType = 202
 	CodeInvalidInput               sdk.CodeType = 203
+	CodeInvalidClaim               sdk.CodeType = 204
+	CodeInvalidCommissionRate      sdk.CodeType = 205
 	CodeInvalidAddress             sdk.CodeType = sdk.CodeInvalidAddress
 	CodeGRpcExecuteMissingParent   sdk.CodeType = 301
 	CodeGRpcExecuteDeployGasError  sdk.CodeType = 302
 	CodeGRpcExecuteDeployExecError sdk.CodeType = 303
+
+	CodeGRpcExecuteDeployPreconditionFailure sdk.CodeType = 304
+	CodeGRpcExecuteUnknownResult             sdk.CodeType = 305
+	CodeGRpcExecuteFailure                   sdk.CodeType = 306
+	CodeGRpcQueryFailure                     sdk.CodeType = 307
+	CodeEEStatePruned                        sdk.CodeType = 308
+
+	CodeInvalidContractName sdk.CodeType = 401
+	CodeContractNameExists  sdk.CodeType = 402
+	CodeContractNotStored   sdk.CodeType = 403
+	CodeContractNotFound    sdk.CodeType = 404
+
+	CodeInvalidUpgradeHeight          sdk.CodeType = 501
+	CodeInvalidUpgradeProtocolVersion sdk.CodeType = 502
+	CodeInvalidWasmCosts              sdk.CodeType = 503
+
+	CodeValidatorJailed     sdk.CodeType = 601
+	CodeValidatorNotJailed  sdk.CodeType = 602
+	CodeValidatorJailedFor  sdk.CodeType = 603
+	CodeNoSigningInfoFound  sdk.CodeType = 604
+	CodeValidatorTombstoned sdk.CodeType = 605
+
+	CodeFeeTooLow              sdk.CodeType = 701
+	CodeDeployTooLarge         sdk.CodeType = 702
+	CodeSessionArgsTooLong     sdk.CodeType = 703
+	CodeProxyMethodUnsupported sdk.CodeType = 704
+
+	CodeInvalidDeployHeader     sdk.CodeType = 801
+	CodeDeployExpired           sdk.CodeType = 802
+	CodeDependencyNotExecuted   sdk.CodeType = 803
+	CodeBlockDeploySizeExceeded sdk.CodeType = 804
+	CodeBlockDeployCostExceeded sdk.CodeType = 805
+	CodeMempoolCostExceeded     sdk.CodeType = 806
+	CodeDeployReserved          sdk.CodeType = 807
 )
 
 // ErrPublicKeyDecode is an error
@@ -67,6 +104,18 @@ func ErrBadDelegationAmount(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "amount must be > 0")
 }
 
+func ErrInvalidClaim(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClaim, "must claim either the reward or the commission")
+}
+
+func ErrInvalidCommissionRate(codespace sdk.CodespaceType, rate, min, max sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCommissionRate, "commission rate %s must be between %s and %s", rate, min, max)
+}
+
+func ErrInvalidDeployArgs(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "invalid deploy arguments : %s", msg)
+}
+
 func ErrGRpcExecuteMissingParent(codespace sdk.CodespaceType, hash string) sdk.Error {
 	return sdk.NewError(codespace, CodeGRpcExecuteMissingParent, "execution engine - missing parent state %s", hash)
 }
@@ -76,7 +125,125 @@ func ErrGRpcExecuteDeployGasError(codespace sdk.CodespaceType) sdk.Error {
 }
 
 func ErrGRpcExecuteDeployExecError(codespace sdk.CodespaceType, msg string) sdk.Error {
-	return sdk.NewError(codespace, CodeGRpcExecuteDeployExecError, "execution engine - deploy error - execute : ", msg)
+	return sdk.NewError(codespace, CodeGRpcExecuteDeployExecError, "execution engine - deploy error - execute : %s", msg)
+}
+
+func ErrGRpcExecuteDeployPreconditionFailure(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeGRpcExecuteDeployPreconditionFailure, "execution engine - deploy error - precondition failure : %s", msg)
+}
+
+func ErrGRpcExecuteUnknownResult(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeGRpcExecuteUnknownResult, "execution engine - unknown result : %s", msg)
+}
+
+func ErrGRpcExecuteFailure(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeGRpcExecuteFailure, "execution engine - execute failed : %s", msg)
+}
+
+func ErrGRpcQueryFailure(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeGRpcQueryFailure, "execution engine - query failed : %s", msg)
+}
+
+func ErrEEStatePruned(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeEEStatePruned, "execution engine - state at height %d is pruned or not available", height)
+}
+
+func ErrInvalidContractName(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidContractName,
+		"invalid contract name %q, must be 1 to %d lower case alphanumerics, '_', '-' or '.'", name, MaxContractNameLength)
+}
+
+func ErrContractNameExists(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeContractNameExists, "contract name %s is already registered", name)
+}
+
+func ErrContractNotStored(codespace sdk.CodespaceType, count int) sdk.Error {
+	return sdk.NewError(codespace, CodeContractNotStored, "the deploy must store exactly one contract, but stored %d", count)
+}
+
+func ErrContractNotFound(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeContractNotFound, "no contract is registered as %s", name)
+}
+
+func ErrInvalidUpgradeHeight(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidUpgradeHeight, "engine upgrade height %d must be in the future", height)
+}
+
+func ErrInvalidUpgradeProtocolVersion(codespace sdk.CodespaceType, next, current string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidUpgradeProtocolVersion,
+		"engine upgrade protocol version %s must be greater than the current protocol version %s", next, current)
+}
+
+func ErrInvalidWasmCosts(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidWasmCosts, "invalid wasm costs : %s", msg)
+}
+
+func ErrNoValidatorFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "validator does not exist for that address")
+}
+
+func ErrValidatorJailed(codespace sdk.CodespaceType, validator sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorJailed, "validator %s is jailed", validator)
+}
+
+func ErrValidatorNotJailed(codespace sdk.CodespaceType, validator sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorNotJailed, "validator %s is not jailed, cannot be unjailed", validator)
+}
+
+func ErrValidatorJailedFor(codespace sdk.CodespaceType, validator sdk.AccAddress, jailedUntil time.Time) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorJailedFor, "validator %s is jailed until %s, cannot be unjailed yet", validator, jailedUntil)
+}
+
+func ErrNoSigningInfoFound(codespace sdk.CodespaceType, consAddr sdk.ConsAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoSigningInfoFound, "no signing info found for validator consensus address %s", consAddr)
+}
+
+func ErrValidatorTombstoned(codespace sdk.CodespaceType, validator sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "validator %s is tombstoned, cannot be unjailed", validator)
+}
+
+func ErrFeeTooLow(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeTooLow, "fee is too low : %s", msg)
+}
+
+func ErrDeployTooLarge(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeDeployTooLarge, "deploy is too large : %s", msg)
+}
+
+func ErrSessionArgsTooLong(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeSessionArgsTooLong, "session args are too long : %s", msg)
+}
+
+func ErrProxyMethodUnsupported(codespace sdk.CodespaceType, method string) sdk.Error {
+	return sdk.NewError(codespace, CodeProxyMethodUnsupported, "the proxy contract does not support %s", method)
+}
+
+func ErrInvalidDeployHeader(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDeployHeader, "invalid deploy header : %s", msg)
+}
+
+func ErrDeployExpired(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeDeployExpired, "deploy expired : %s", msg)
+}
+
+func ErrDependencyNotExecuted(codespace sdk.CodespaceType, deployHash string) sdk.Error {
+	return sdk.NewError(codespace, CodeDependencyNotExecuted, "dependency deploy %s is not executed", deployHash)
+}
+
+func ErrBlockDeploySizeExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeBlockDeploySizeExceeded, "deploys exceed the max block size : %s", msg)
+}
+
+func ErrBlockDeployCostExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeBlockDeployCostExceeded, "deploys exceed the max block cost : %s", msg)
+}
+
+func ErrMempoolCostExceeded(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeMempoolCostExceeded, "deploys in the mempool exceed the max block cost, retry after the next block : %s", msg)
+}
+
+func ErrDeployReserved(codespace sdk.CodespaceType, key string) sdk.Error {
+	return sdk.NewError(codespace, CodeDeployReserved, "reserved by another deploy of the block : %s", key)
 }
 
 func ErrValidatorOwnerExists(codespace sdk.CodespaceType) sdk.Error {
@@ -0,0 +1,60 @@
+package types
+
+// executionlayer module event types
+const (
+	EventTypeExecute         = "execute"
+	EventTypeTransfer        = "transfer"
+	EventTypeCreateValidator = "create_validator"
+	EventTypeEditValidator   = "edit_validator"
+	EventTypeBond            = "bond"
+	EventTypeUnbond          = "unbond"
+	EventTypeDelegate        = "delegate"
+	EventTypeUndelegate      = "undelegate"
+	EventTypeRedelegate      = "redelegate"
+	EventTypeVote            = "vote"
+	EventTypeUnvote          = "unvote"
+	EventTypeClaimReward     = "claim_reward"
+	EventTypeClaimCommission = "claim_commission"
+	EventTypeDeployContract  = "deploy_contract"
+	EventTypeEngineUpgrade   = "engine_upgrade"
+	EventTypeLiveness        = "liveness"
+	EventTypeJail            = "jail"
+	EventTypeUnjail          = "unjail"
+	EventTypeSlash           = "slash"
+
+	EventTypeAddAssociatedKey    = "add_associated_key"
+	EventTypeRemoveAssociatedKey = "remove_associated_key"
+	EventTypeSetActionThresholds = "set_action_thresholds"
+
+	AttributeKeySender       = "sender"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyFee          = "fee"
+	AttributeKeyFeePayer     = "fee_payer"
+	AttributeKeyValidator    = "validator"
+	AttributeKeySrcValidator = "source_validator"
+	AttributeKeyDstValidator = "destination_validator"
+	AttributeKeyContract     = "contract"
+	AttributeKeyDeployHash   = "deploy_hash"
+	AttributeKeyName         = "name"
+	AttributeKeyCodeHash     = "code_hash"
+
+	AttributeKeyAssociatedKey          = "associated_key"
+	AttributeKeyWeight                 = "weight"
+	AttributeKeyDeploymentThreshold    = "deployment_threshold"
+	AttributeKeyKeyManagementThreshold = "key_management_threshold"
+
+	AttributeKeyProtocolVersion = "protocol_version"
+	AttributeKeyPostStateHash   = "post_state_hash"
+
+	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyHeight       = "height"
+	AttributeKeyJailedUntil  = "jailed_until"
+	AttributeKeyReason       = "reason"
+	AttributeKeyFraction     = "fraction"
+
+	AttributeValueMissingSignature = "missing_signature"
+	AttributeValueDoubleSign       = "double_sign"
+
+	AttributeValueCategory = ModuleName
+)
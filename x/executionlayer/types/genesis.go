@@ -19,6 +19,10 @@ type GenesisState struct {
 	ChainName   string      `json:"chain_name"`
 	Validators  []Validator `json:"validators"`
 	StateInfos  []string    `json:"state_infos"`
+	Params      Params      `json:"params"`
+
+	SigningInfos []ValidatorSigningInfo `json:"signing_infos"`
+	SlashRecords SlashRecords           `json:"slash_records"`
 }
 
 // GenesisConf : the executionlayer configuration that must be provided at genesis.
@@ -83,18 +87,30 @@ const (
 )
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(genesisConf GenesisConf, accounts []Account, chainName string, validators Validators, stateInfos []string) GenesisState {
-	return GenesisState{GenesisConf: genesisConf, Accounts: accounts, ChainName: chainName, Validators: validators, StateInfos: stateInfos}
+func NewGenesisState(genesisConf GenesisConf, accounts []Account, chainName string, validators Validators, stateInfos []string, params Params,
+	signingInfos []ValidatorSigningInfo, slashRecords SlashRecords) GenesisState {
+	return GenesisState{GenesisConf: genesisConf, Accounts: accounts, ChainName: chainName, Validators: validators, StateInfos: stateInfos, Params: params,
+		SigningInfos: signingInfos, SlashRecords: slashRecords}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	genesisConf := GenesisConf{
+	genesisConf := DefaultGenesisConf(
+		util.LoadWasmFile(os.ExpandEnv(mintCodePath)),
+		util.LoadWasmFile(os.ExpandEnv(posCodePath)),
+		util.LoadWasmFile(os.ExpandEnv(standardPaymentCodePath)),
+	)
+	return NewGenesisState(genesisConf, nil, "friday-devnet", nil, nil, DefaultParams(), nil, nil)
+}
+
+// DefaultGenesisConf returns the default genesis config with the given system contracts
+func DefaultGenesisConf(mintWasm, posWasm, standardPaymentWasm []byte) GenesisConf {
+	return GenesisConf{
 		Genesis: Genesis{
 			Timestamp:           0,
-			MintWasm:            util.LoadWasmFile(os.ExpandEnv(mintCodePath)),
-			PosWasm:             util.LoadWasmFile(os.ExpandEnv(posCodePath)),
-			StandardPaymentWasm: util.LoadWasmFile(os.ExpandEnv(standardPaymentCodePath)),
+			MintWasm:            mintWasm,
+			PosWasm:             posWasm,
+			StandardPaymentWasm: standardPaymentWasm,
 			ProtocolVersion:     "1.0.0",
 		},
 		WasmCosts: WasmCosts{
@@ -125,11 +141,13 @@ func DefaultGenesisState() GenesisState {
 			Ftt:                        0,
 		},
 	}
-	return NewGenesisState(genesisConf, nil, "friday-devnet", nil, nil)
 }
 
 // ValidateGenesis :
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
 	_, err := ToChainSpecGenesisConfig(data)
 	return err
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/hdac-io/friday/types"
 )
@@ -27,6 +28,19 @@ var (
 	EEStateKey              = []byte{0x11}
 	ValidatorKey            = []byte{0x21}
 	ValidatorsByConsAddrKey = []byte{0x22}
+
+	RegisteredContractKey           = []byte{0x31}
+	RegisteredContractByDeployerKey = []byte{0x32}
+
+	ScheduledEngineUpgradeKey = []byte{0x41}
+	AppliedEngineUpgradeKey   = []byte{0x42}
+
+	ValidatorSigningInfoKey         = []byte{0x51}
+	ValidatorMissedBlockBitArrayKey = []byte{0x52}
+	SlashRecordKey                  = []byte{0x53}
+
+	ExecutedDeployKey       = []byte{0x61}
+	ExecutedDeployByTimeKey = []byte{0x62}
 )
 
 type (
@@ -49,3 +63,73 @@ func GetValidatorKey(operatorAddr sdk.AccAddress) []byte {
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
 	return append(ValidatorsByConsAddrKey, addr.Bytes()...)
 }
+
+// GetRegisteredContractKey returns the key of the contract registered as name
+func GetRegisteredContractKey(name string) []byte {
+	return append(RegisteredContractKey, []byte(name)...)
+}
+
+// GetRegisteredContractsByDeployerKey returns the prefix of the contracts registered by deployer
+func GetRegisteredContractsByDeployerKey(deployer sdk.AccAddress) []byte {
+	key := append(RegisteredContractByDeployerKey, byte(len(deployer)))
+	return append(key, deployer.Bytes()...)
+}
+
+// GetRegisteredContractByDeployerKey returns the index key of the contract registered as name by deployer
+func GetRegisteredContractByDeployerKey(deployer sdk.AccAddress, name string) []byte {
+	return append(GetRegisteredContractsByDeployerKey(deployer), []byte(name)...)
+}
+
+// GetAppliedEngineUpgradeKey returns the key of the engine upgrade applied at height
+func GetAppliedEngineUpgradeKey(height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(AppliedEngineUpgradeKey, heightBytes...)
+}
+
+// GetValidatorSigningInfoKey returns the key of the signing info of a validator
+func GetValidatorSigningInfoKey(consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorSigningInfoKey, consAddr.Bytes()...)
+}
+
+// GetValidatorMissedBlockBitArrayPrefixKey returns the prefix of the missed block bit array of a validator
+func GetValidatorMissedBlockBitArrayPrefixKey(consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockBitArrayKey, consAddr.Bytes()...)
+}
+
+// GetValidatorMissedBlockBitArrayKey returns the key of the missed block bit array of a validator at index
+func GetValidatorMissedBlockBitArrayKey(consAddr sdk.ConsAddress, index int64) []byte {
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, uint64(index))
+	return append(GetValidatorMissedBlockBitArrayPrefixKey(consAddr), indexBytes...)
+}
+
+// GetSlashRecordsByValidatorKey returns the prefix of the slash records of a validator
+func GetSlashRecordsByValidatorKey(operatorAddr sdk.AccAddress) []byte {
+	key := append(SlashRecordKey, byte(len(operatorAddr)))
+	return append(key, operatorAddr.Bytes()...)
+}
+
+// GetSlashRecordKey returns the key of the slash record of a validator at height
+func GetSlashRecordKey(operatorAddr sdk.AccAddress, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return append(GetSlashRecordsByValidatorKey(operatorAddr), heightBytes...)
+}
+
+// GetExecutedDeployKey returns the key of an executed deploy
+func GetExecutedDeployKey(deployHash []byte) []byte {
+	return append(ExecutedDeployKey, deployHash...)
+}
+
+// GetExecutedDeploysByTimeKey returns the prefix of the deploys executed at the block time
+func GetExecutedDeploysByTimeKey(blockTime time.Time) []byte {
+	timeBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(timeBytes, uint64(blockTime.UnixNano()/int64(time.Millisecond)))
+	return append(ExecutedDeployByTimeKey, timeBytes...)
+}
+
+// GetExecutedDeployByTimeKey returns the index key of a deploy executed at the block time
+func GetExecutedDeployByTimeKey(blockTime time.Time, deployHash []byte) []byte {
+	return append(GetExecutedDeploysByTimeKey(blockTime), deployHash...)
+}
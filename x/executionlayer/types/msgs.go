@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
@@ -12,6 +13,22 @@ import (
 // RouterKey is not in sense yet
 const RouterKey = ModuleName
 
+// DeployMsg is a message which is executed as a deploy of the execution engine.
+// The optional header of the deploy is checked by the ante handler.
+type DeployMsg interface {
+	sdk.Msg
+	GetFee() string
+	GetHeader() *DeployHeader
+}
+
+// ReservingMsg is a deploy message which reserves keys for the rest of the candidate block,
+// e.g. the names which are set after the deploy is executed.
+// The ante handler rejects a deploy on the keys reserved by a deploy before.
+type ReservingMsg interface {
+	DeployMsg
+	GetReservations() []string
+}
+
 // MsgExecute for sending deploy to execution engine
 type MsgExecute struct {
 	ContractAddress string            `json:"contract_address"`
@@ -20,6 +37,10 @@ type MsgExecute struct {
 	SessionCode     []byte            `json:"session_code"`
 	SessionArgs     string            `json:"session_args"`
 	Fee             string            `json:"fee"`
+	Header          *DeployHeader     `json:"header,omitempty"`
+	FeePayer        sdk.AccAddress    `json:"fee_payer,omitempty"`
+	// AuthorizationKeys are the associated keys of the account which authorize the deploy together with the account
+	AuthorizationKeys []sdk.AccAddress `json:"authorization_keys,omitempty"`
 }
 
 // NewMsgExecute is a constructor function for MsgSetName
@@ -52,7 +73,12 @@ func (msg MsgExecute) ValidateBasic() sdk.Error {
 	if msg.ExecAddress.Equals(sdk.AccAddress("")) {
 		return sdk.ErrUnknownRequest("Address cannot be empty")
 	}
-	return nil
+	if msg.Header != nil {
+		if err := msg.Header.Validate(); err != nil {
+			return ErrInvalidDeployHeader(DefaultCodespace, err.Error())
+		}
+	}
+	return validateAuthorizationKeys(msg.AuthorizationKeys)
 }
 
 // GetSignBytes encodes the message for signing
@@ -62,7 +88,54 @@ func (msg MsgExecute) GetSignBytes() []byte {
 
 // GetSigners defines whose signature is required
 func (msg MsgExecute) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.ExecAddress}
+	return deploySigners(msg.ExecAddress, msg.AuthorizationKeys, msg.FeePayer)
+}
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgExecute) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgExecute) GetHeader() *DeployHeader { return msg.Header }
+
+// deploySigners returns the signers of a deploy of the account.
+// The account always signs the deploy, and the authorization keys and the fee payer co-sign the tx.
+// The engine does not enforce the action thresholds on the authorization keys alone, so they never replace the account.
+func deploySigners(account sdk.AccAddress, authorizationKeys []sdk.AccAddress, feePayer sdk.AccAddress) []sdk.AccAddress {
+	signers := []sdk.AccAddress{account}
+	for _, key := range authorizationKeys {
+		if !containsAddress(signers, key) {
+			signers = append(signers, key)
+		}
+	}
+	if !feePayer.Empty() && !containsAddress(signers, feePayer) {
+		signers = append(signers, feePayer)
+	}
+	return signers
+}
+
+// containsAddress returns whether the address is in the addresses
+func containsAddress(addresses []sdk.AccAddress, address sdk.AccAddress) bool {
+	for _, addr := range addresses {
+		if addr.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAuthorizationKeys checks that the authorization keys are not empty nor duplicated
+func validateAuthorizationKeys(authorizationKeys []sdk.AccAddress) sdk.Error {
+	seen := map[string]bool{}
+	for _, key := range authorizationKeys {
+		if key.Empty() {
+			return sdk.ErrUnknownRequest("Authorization key cannot be empty")
+		}
+		if seen[key.String()] {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("duplicated authorization key %s", key))
+		}
+		seen[key.String()] = true
+	}
+	return nil
 }
 
 // MsgTransfer for sending deploy to execution engine
@@ -72,6 +145,10 @@ type MsgTransfer struct {
 	ToAddress       sdk.AccAddress `json:"to_address" yaml:"to_address"`
 	Amount          string         `json:"amount" yaml:"amount"`
 	Fee             string         `json:"fee" yaml:"fee"`
+	Header          *DeployHeader  `json:"header,omitempty"`
+	FeePayer        sdk.AccAddress `json:"fee_payer,omitempty" yaml:"fee_payer"`
+	// AuthorizationKeys are the associated keys of the account which authorize the deploy together with the account
+	AuthorizationKeys []sdk.AccAddress `json:"authorization_keys,omitempty" yaml:"authorization_keys"`
 }
 
 // NewMsgTransfer is a constructor function for MsgSetName
@@ -103,7 +180,7 @@ func (msg MsgTransfer) ValidateBasic() sdk.Error {
 	if msg.ToAddress.Equals(sdk.AccAddress("")) {
 		return sdk.ErrUnknownRequest("Address cannot be empty")
 	}
-	return nil
+	return validateAuthorizationKeys(msg.AuthorizationKeys)
 }
 
 // GetSignBytes encodes the message for signing
@@ -113,9 +190,98 @@ func (msg MsgTransfer) GetSignBytes() []byte {
 
 // GetSigners defines whose signature is required
 func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.FromAddress}
+	return deploySigners(msg.FromAddress, msg.AuthorizationKeys, msg.FeePayer)
+}
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgTransfer) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgTransfer) GetHeader() *DeployHeader { return msg.Header }
+
+// TransferOutput is a pair of a recipient and the amount sent to the recipient
+type TransferOutput struct {
+	ToAddress sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount    string         `json:"amount" yaml:"amount"`
+}
+
+// NewTransferOutput is a constructor function for TransferOutput
+func NewTransferOutput(toAddress sdk.AccAddress, amount string) TransferOutput {
+	return TransferOutput{
+		ToAddress: toAddress,
+		Amount:    amount,
+	}
 }
 
+// MsgMultiTransfer for sending funds to many recipients in a single deploy.
+// The transfers are all or nothing.
+type MsgMultiTransfer struct {
+	ContractAddress string           `json:"contract_address" yaml:"contract_address"`
+	FromAddress     sdk.AccAddress   `json:"from_address" yaml:"from_address"`
+	Outputs         []TransferOutput `json:"outputs" yaml:"outputs"`
+	Fee             string           `json:"fee" yaml:"fee"`
+	Header          *DeployHeader    `json:"header,omitempty"`
+	FeePayer        sdk.AccAddress   `json:"fee_payer,omitempty" yaml:"fee_payer"`
+	// AuthorizationKeys are the associated keys of the account which authorize the deploy together with the account
+	AuthorizationKeys []sdk.AccAddress `json:"authorization_keys,omitempty" yaml:"authorization_keys"`
+}
+
+// NewMsgMultiTransfer is a constructor function for MsgMultiTransfer
+func NewMsgMultiTransfer(
+	tokenContractAddress string,
+	fromAddress sdk.AccAddress,
+	outputs []TransferOutput,
+	fee string,
+) MsgMultiTransfer {
+	return MsgMultiTransfer{
+		ContractAddress: tokenContractAddress,
+		FromAddress:     fromAddress,
+		Outputs:         outputs,
+		Fee:             fee,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgMultiTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMultiTransfer) Type() string { return "executionengine" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMultiTransfer) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Equals(sdk.AccAddress("")) {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if len(msg.Outputs) == 0 {
+		return sdk.ErrUnknownRequest("Outputs cannot be empty")
+	}
+	for _, output := range msg.Outputs {
+		if output.ToAddress.Equals(sdk.AccAddress("")) {
+			return sdk.ErrUnknownRequest("Address cannot be empty")
+		}
+		if amount, ok := sdk.NewIntFromString(output.Amount); !ok || !amount.IsPositive() {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("invalid amount %s to %s", output.Amount, output.ToAddress))
+		}
+	}
+	return validateAuthorizationKeys(msg.AuthorizationKeys)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMultiTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMultiTransfer) GetSigners() []sdk.AccAddress {
+	return deploySigners(msg.FromAddress, msg.AuthorizationKeys, msg.FeePayer)
+}
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgMultiTransfer) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgMultiTransfer) GetHeader() *DeployHeader { return msg.Header }
+
 //______________________________________________________________________
 // MsgCreateValidator - struct for bonding transactions
 type MsgCreateValidator struct {
@@ -123,7 +289,9 @@ type MsgCreateValidator struct {
 	ValidatorAddress sdk.AccAddress `json:"validator_address" yaml:"validator_address"`
 	ConsPubKey       crypto.PubKey  `json:"cons_pubkey" yaml:"cons_pubkey"`
 	Description      Description    `json:"description" yaml:"description"`
+	CommissionRate   sdk.Dec        `json:"commission_rate" yaml:"commission_rate"`
 	Fee              string         `json:"fee" yaml:"fee"`
+	Header           *DeployHeader  `json:"header,omitempty"`
 }
 
 type msgCreateValidatorJSON struct {
@@ -131,7 +299,9 @@ type msgCreateValidatorJSON struct {
 	ValidatorAddress sdk.AccAddress `json:"validator_address" yaml:"validator_address"`
 	ConsPubKey       string         `json:"cons_pubkey" yaml:"cons_pubkey"`
 	Description      Description    `json:"description" yaml:"description"`
+	CommissionRate   sdk.Dec        `json:"commission_rate" yaml:"commission_rate"`
 	Fee              string         `json:"fee" yaml:"fee"`
+	Header           *DeployHeader  `json:"header,omitempty" yaml:"header"`
 }
 
 // Default way to create validator. Delegator address and validator address are the same
@@ -140,6 +310,7 @@ func NewMsgCreateValidator(
 	valAddress sdk.AccAddress,
 	consPubKey crypto.PubKey,
 	description Description,
+	commissionRate sdk.Dec,
 	fee string,
 ) MsgCreateValidator {
 	return MsgCreateValidator{
@@ -147,6 +318,7 @@ func NewMsgCreateValidator(
 		ValidatorAddress: valAddress,
 		ConsPubKey:       consPubKey,
 		Description:      description,
+		CommissionRate:   commissionRate,
 		Fee:              fee,
 	}
 }
@@ -163,6 +335,12 @@ func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgCreateValidator) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgCreateValidator) GetHeader() *DeployHeader { return msg.Header }
+
 // MarshalJSON implements the json.Marshaler interface to provide custom JSON
 // serialization of the MsgCreateValidator type.
 func (msg MsgCreateValidator) MarshalJSON() ([]byte, error) {
@@ -171,7 +349,9 @@ func (msg MsgCreateValidator) MarshalJSON() ([]byte, error) {
 		ValidatorAddress: msg.ValidatorAddress,
 		ConsPubKey:       sdk.MustBech32ifyConsPub(msg.ConsPubKey),
 		Description:      msg.Description,
+		CommissionRate:   msg.CommissionRate,
 		Fee:              msg.Fee,
+		Header:           msg.Header,
 	})
 }
 
@@ -184,11 +364,13 @@ func (msg *MsgCreateValidator) UnmarshalJSON(bz []byte) error {
 	}
 
 	msg.Description = msgCreateValJSON.Description
+	msg.CommissionRate = msgCreateValJSON.CommissionRate
 	msg.ValidatorAddress = msgCreateValJSON.ValidatorAddress
 	var err error
 	msg.ConsPubKey, err = sdk.GetConsPubKeyBech32(msgCreateValJSON.ConsPubKey)
 	msg.ContractAddress = msgCreateValJSON.ContractAddress
 	msg.Fee = msgCreateValJSON.Fee
+	msg.Header = msgCreateValJSON.Header
 	if err != nil {
 		return err
 	}
@@ -211,6 +393,9 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 	if msg.Description == (Description{}) {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "description must be included")
 	}
+	if err := validateCommissionRate(msg.CommissionRate); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -221,14 +406,17 @@ type MsgEditValidator struct {
 	ContractAddress  string         `json:"contract_address" yaml:"contract_address"`
 	ValidatorAddress sdk.AccAddress `json:"address" yaml:"address"`
 	Description      Description    `json:"description" yaml:"description"`
+	CommissionRate   *sdk.Dec       `json:"commission_rate,omitempty" yaml:"commission_rate,omitempty"` // nil if the rate is not changed
 	Fee              string         `json:"fee" yaml:"fee"`
+	Header           *DeployHeader  `json:"header,omitempty"`
 }
 
-func NewMsgEditValidator(contractAddress string, valAddr sdk.AccAddress, description Description, fee string) MsgEditValidator {
+func NewMsgEditValidator(contractAddress string, valAddr sdk.AccAddress, description Description, commissionRate *sdk.Dec, fee string) MsgEditValidator {
 	return MsgEditValidator{
 		ContractAddress:  contractAddress,
 		ValidatorAddress: valAddr,
 		Description:      description,
+		CommissionRate:   commissionRate,
 		Fee:              fee,
 	}
 }
@@ -240,6 +428,12 @@ func (msg MsgEditValidator) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.ValidatorAddress}
 }
 
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgEditValidator) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgEditValidator) GetHeader() *DeployHeader { return msg.Header }
+
 // get the bytes for the message signer to sign on
 func (msg MsgEditValidator) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
@@ -255,6 +449,108 @@ func (msg MsgEditValidator) ValidateBasic() sdk.Error {
 	if msg.Description == (Description{}) {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "transaction must include some information to modify")
 	}
+	if msg.CommissionRate != nil {
+		if err := validateCommissionRate(*msg.CommissionRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCommissionRate checks that the commission rate is between 0 and 1.
+// The bounds in the params are checked by the handler.
+func validateCommissionRate(rate sdk.Dec) sdk.Error {
+	if rate.IsNil() || rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return ErrInvalidCommissionRate(DefaultCodespace, rate, sdk.ZeroDec(), sdk.OneDec())
+	}
+	return nil
+}
+
+//______________________________________________________________________
+// MsgJail - struct for jailing a validator by itself, e.g. for a maintenance
+type MsgJail struct {
+	ContractAddress  string         `json:"contract_address" yaml:"contract_address"`
+	ValidatorAddress sdk.AccAddress `json:"address" yaml:"address"`
+	Fee              string         `json:"fee" yaml:"fee"`
+	Header           *DeployHeader  `json:"header,omitempty"`
+}
+
+func NewMsgJail(contractAddress string, valAddr sdk.AccAddress, fee string) MsgJail {
+	return MsgJail{
+		ContractAddress:  contractAddress,
+		ValidatorAddress: valAddr,
+		Fee:              fee,
+	}
+}
+
+//nolint
+func (msg MsgJail) Route() string { return RouterKey }
+func (msg MsgJail) Type() string  { return "jail" }
+func (msg MsgJail) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ValidatorAddress}
+}
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgJail) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgJail) GetHeader() *DeployHeader { return msg.Header }
+
+// get the bytes for the message signer to sign on
+func (msg MsgJail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgJail) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+// MsgUnjail - struct for unjailing a jailed validator
+type MsgUnjail struct {
+	ContractAddress  string         `json:"contract_address" yaml:"contract_address"`
+	ValidatorAddress sdk.AccAddress `json:"address" yaml:"address"`
+	Fee              string         `json:"fee" yaml:"fee"`
+	Header           *DeployHeader  `json:"header,omitempty"`
+}
+
+func NewMsgUnjail(contractAddress string, valAddr sdk.AccAddress, fee string) MsgUnjail {
+	return MsgUnjail{
+		ContractAddress:  contractAddress,
+		ValidatorAddress: valAddr,
+		Fee:              fee,
+	}
+}
+
+//nolint
+func (msg MsgUnjail) Route() string { return RouterKey }
+func (msg MsgUnjail) Type() string  { return "unjail" }
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ValidatorAddress}
+}
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgUnjail) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgUnjail) GetHeader() *DeployHeader { return msg.Header }
+
+// get the bytes for the message signer to sign on
+func (msg MsgUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgUnjail) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
 	return nil
 }
 
@@ -264,6 +560,7 @@ type MsgBond struct {
 	FromAddress     sdk.AccAddress `json:"from_address" yaml:"from_address"`
 	Amount          string         `json:"amount" yaml:"amount"`
 	Fee             string         `json:"fee" yaml:"fee"`
+	Header          *DeployHeader  `json:"header,omitempty"`
 }
 
 // NewMsgBond is a constructor function for MsgSetName
@@ -304,12 +601,19 @@ func (msg MsgBond) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgBond) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgBond) GetHeader() *DeployHeader { return msg.Header }
+
 //______________________________________________________________________
 type MsgUnBond struct {
 	ContractAddress string         `json:"contract_address" yaml:"contract_address"`
 	FromAddress     sdk.AccAddress `json:"from_address" yaml:"from_address"`
 	Amount          string         `json:"amount" yaml:"amount"`
 	Fee             string         `json:"fee" yaml:"fee"`
+	Header          *DeployHeader  `json:"header,omitempty"`
 }
 
 // NewMsgUnBond is a constructor function for MsgSetName
@@ -350,6 +654,12 @@ func (msg MsgUnBond) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgUnBond) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgUnBond) GetHeader() *DeployHeader { return msg.Header }
+
 //______________________________________________________________________
 type MsgDelegate struct {
 	ContractAddress string         `json:"contract_address" yaml:"contract_address"`
@@ -357,6 +667,7 @@ type MsgDelegate struct {
 	ValAddress      sdk.AccAddress `json:"val_address" yaml:"val_address"`
 	Amount          string         `json:"amount" yaml:"amount"`
 	Fee             string         `json:"fee" yaml:"fee"`
+	Header          *DeployHeader  `json:"header,omitempty"`
 }
 
 // NewMsgDelegate is a constructor function for MsgSetName
@@ -398,6 +709,12 @@ func (msg MsgDelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgDelegate) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgDelegate) GetHeader() *DeployHeader { return msg.Header }
+
 //______________________________________________________________________
 type MsgUndelegate struct {
 	ContractAddress string         `json:"contract_address" yaml:"contract_address"`
@@ -405,6 +722,7 @@ type MsgUndelegate struct {
 	ValAddress      sdk.AccAddress `json:"val_address" yaml:"val_address"`
 	Amount          string         `json:"amount" yaml:"amount"`
 	Fee             string         `json:"fee" yaml:"fee"`
+	Header          *DeployHeader  `json:"header,omitempty"`
 }
 
 // NewMsgUndelegate is a constructor function for MsgSetName
@@ -446,6 +764,12 @@ func (msg MsgUndelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgUndelegate) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgUndelegate) GetHeader() *DeployHeader { return msg.Header }
+
 //______________________________________________________________________
 type MsgRedelegate struct {
 	ContractAddress string         `json:"contract_address" yaml:"contract_address"`
@@ -454,6 +778,7 @@ type MsgRedelegate struct {
 	DestValAddress  sdk.AccAddress `json:"dest_val_address" yaml:"dest_val_address"`
 	Amount          string         `json:"amount" yaml:"amount"`
 	Fee             string         `json:"fee" yaml:"fee"`
+	Header          *DeployHeader  `json:"header,omitempty"`
 }
 
 // MsgRedelegate is a constructor function for MsgSetName
@@ -496,6 +821,12 @@ func (msg MsgRedelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgRedelegate) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgRedelegate) GetHeader() *DeployHeader { return msg.Header }
+
 //______________________________________________________________________
 type MsgVote struct {
 	ContractAddress       string         `json:"contract_address" yaml:"contract_address"`
@@ -503,6 +834,7 @@ type MsgVote struct {
 	TargetContractAddress string         `json:"target_contract_address" yaml:"target_contract_address"`
 	Amount                string         `json:"amount" yaml:"amount"`
 	Fee                   string         `json:"fee" yaml:"fee"`
+	Header                *DeployHeader  `json:"header,omitempty"`
 }
 
 // NewMsgVote is a constructor function for MsgSetName
@@ -563,6 +895,12 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgVote) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgVote) GetHeader() *DeployHeader { return msg.Header }
+
 //______________________________________________________________________
 type MsgUnvote struct {
 	ContractAddress       string         `json:"contract_address" yaml:"contract_address"`
@@ -570,6 +908,7 @@ type MsgUnvote struct {
 	TargetContractAddress string         `json:"target_contract_address" yaml:"target_contract_address"`
 	Amount                string         `json:"amount" yaml:"amount"`
 	Fee                   string         `json:"fee" yaml:"fee"`
+	Header                *DeployHeader  `json:"header,omitempty"`
 }
 
 // NewMsgUnvote is a constructor function for MsgSetName
@@ -630,12 +969,19 @@ func (msg MsgUnvote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgUnvote) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgUnvote) GetHeader() *DeployHeader { return msg.Header }
+
 //______________________________________________________________________
 type MsgClaim struct {
 	ContractAddress    string         `json:"contract_address" yaml:"contract_address"`
 	FromAddress        sdk.AccAddress `json:"from_address" yaml:"from_address"`
 	RewardOrCommission bool           `json:"reward_or_commission" yaml:"reward_or_commission"`
 	Fee                string         `json:"fee" yaml:"fee"`
+	Header             *DeployHeader  `json:"header,omitempty"`
 }
 
 // NewMsgClaim is a constructor function for MsgSetName
@@ -664,9 +1010,24 @@ func (msg MsgClaim) ValidateBasic() sdk.Error {
 	if msg.FromAddress.Equals(sdk.AccAddress("")) {
 		return sdk.ErrUnknownRequest("Address cannot be empty")
 	}
+	if _, err := msg.MethodName(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// MethodName returns the method of the proxy contract claiming the reward or the commission
+func (msg MsgClaim) MethodName() (string, sdk.Error) {
+	switch msg.RewardOrCommission {
+	case CommissionValue:
+		return ClaimCommissionMethodName, nil
+	case RewardValue:
+		return ClaimRewardMethodName, nil
+	default:
+		return "", ErrInvalidClaim(DefaultCodespace)
+	}
+}
+
 // GetSignBytes encodes the message for signing
 func (msg MsgClaim) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -676,3 +1037,271 @@ func (msg MsgClaim) GetSignBytes() []byte {
 func (msg MsgClaim) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgClaim) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgClaim) GetHeader() *DeployHeader { return msg.Header }
+
+//______________________________________________________________________
+// MsgDeployContract deploys wasm session code, which stores a contract,
+// and registers the stored contract under the name
+type MsgDeployContract struct {
+	DeployerAddress sdk.AccAddress `json:"deployer_address" yaml:"deployer_address"`
+	Name            string         `json:"name" yaml:"name"`
+	SessionCode     []byte         `json:"session_code" yaml:"session_code"`
+	SessionArgs     string         `json:"session_args" yaml:"session_args"`
+	Metadata        string         `json:"metadata" yaml:"metadata"`
+	Fee             string         `json:"fee" yaml:"fee"`
+	Header          *DeployHeader  `json:"header,omitempty"`
+}
+
+// NewMsgDeployContract is a constructor function for MsgDeployContract
+func NewMsgDeployContract(
+	deployerAddress sdk.AccAddress,
+	name string,
+	sessionCode []byte,
+	sessionArgs string,
+	metadata string,
+	fee string,
+) MsgDeployContract {
+	return MsgDeployContract{
+		DeployerAddress: deployerAddress,
+		Name:            name,
+		SessionCode:     sessionCode,
+		SessionArgs:     sessionArgs,
+		Metadata:        metadata,
+		Fee:             fee,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDeployContract) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDeployContract) Type() string { return "deploy_contract" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDeployContract) ValidateBasic() sdk.Error {
+	if msg.DeployerAddress.Equals(sdk.AccAddress("")) {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if err := ValidateContractName(msg.Name); err != nil {
+		return err
+	}
+	if len(msg.SessionCode) == 0 {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "session code cannot be empty")
+	}
+	if len(msg.Metadata) > MaxContractMetadataLength {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "metadata is longer than %d", MaxContractMetadataLength)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeployContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeployContract) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DeployerAddress}
+}
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgDeployContract) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgDeployContract) GetHeader() *DeployHeader { return msg.Header }
+
+//______________________________________________________________________
+// MsgAddAssociatedKey adds an associated key with the weight to the account
+type MsgAddAssociatedKey struct {
+	ContractAddress   string           `json:"contract_address" yaml:"contract_address"`
+	AccountAddress    sdk.AccAddress   `json:"account_address" yaml:"account_address"`
+	AssociatedKey     sdk.AccAddress   `json:"associated_key" yaml:"associated_key"`
+	Weight            uint8            `json:"weight" yaml:"weight"`
+	Fee               string           `json:"fee" yaml:"fee"`
+	Header            *DeployHeader    `json:"header,omitempty"`
+	AuthorizationKeys []sdk.AccAddress `json:"authorization_keys,omitempty" yaml:"authorization_keys"`
+}
+
+// NewMsgAddAssociatedKey is a constructor function for MsgAddAssociatedKey
+func NewMsgAddAssociatedKey(
+	tokenContractAddress string,
+	accountAddress, associatedKey sdk.AccAddress,
+	weight uint8, fee string,
+	authorizationKeys []sdk.AccAddress,
+) MsgAddAssociatedKey {
+	return MsgAddAssociatedKey{
+		ContractAddress:   tokenContractAddress,
+		AccountAddress:    accountAddress,
+		AssociatedKey:     associatedKey,
+		Weight:            weight,
+		Fee:               fee,
+		AuthorizationKeys: authorizationKeys,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAddAssociatedKey) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAddAssociatedKey) Type() string { return "executionengine" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAddAssociatedKey) ValidateBasic() sdk.Error {
+	if msg.AccountAddress.Equals(sdk.AccAddress("")) {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if msg.AssociatedKey.Equals(sdk.AccAddress("")) {
+		return sdk.ErrUnknownRequest("Associated key cannot be empty")
+	}
+	if msg.Weight == 0 {
+		return sdk.ErrUnknownRequest("Weight must be positive")
+	}
+	return validateAuthorizationKeys(msg.AuthorizationKeys)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddAssociatedKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAddAssociatedKey) GetSigners() []sdk.AccAddress {
+	return deploySigners(msg.AccountAddress, msg.AuthorizationKeys, nil)
+}
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgAddAssociatedKey) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgAddAssociatedKey) GetHeader() *DeployHeader { return msg.Header }
+
+//______________________________________________________________________
+// MsgRemoveAssociatedKey removes an associated key from the account
+type MsgRemoveAssociatedKey struct {
+	ContractAddress   string           `json:"contract_address" yaml:"contract_address"`
+	AccountAddress    sdk.AccAddress   `json:"account_address" yaml:"account_address"`
+	AssociatedKey     sdk.AccAddress   `json:"associated_key" yaml:"associated_key"`
+	Fee               string           `json:"fee" yaml:"fee"`
+	Header            *DeployHeader    `json:"header,omitempty"`
+	AuthorizationKeys []sdk.AccAddress `json:"authorization_keys,omitempty" yaml:"authorization_keys"`
+}
+
+// NewMsgRemoveAssociatedKey is a constructor function for MsgRemoveAssociatedKey
+func NewMsgRemoveAssociatedKey(
+	tokenContractAddress string,
+	accountAddress, associatedKey sdk.AccAddress,
+	fee string,
+	authorizationKeys []sdk.AccAddress,
+) MsgRemoveAssociatedKey {
+	return MsgRemoveAssociatedKey{
+		ContractAddress:   tokenContractAddress,
+		AccountAddress:    accountAddress,
+		AssociatedKey:     associatedKey,
+		Fee:               fee,
+		AuthorizationKeys: authorizationKeys,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRemoveAssociatedKey) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRemoveAssociatedKey) Type() string { return "executionengine" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRemoveAssociatedKey) ValidateBasic() sdk.Error {
+	if msg.AccountAddress.Equals(sdk.AccAddress("")) {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if msg.AssociatedKey.Equals(sdk.AccAddress("")) {
+		return sdk.ErrUnknownRequest("Associated key cannot be empty")
+	}
+	return validateAuthorizationKeys(msg.AuthorizationKeys)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveAssociatedKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRemoveAssociatedKey) GetSigners() []sdk.AccAddress {
+	return deploySigners(msg.AccountAddress, msg.AuthorizationKeys, nil)
+}
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgRemoveAssociatedKey) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgRemoveAssociatedKey) GetHeader() *DeployHeader { return msg.Header }
+
+//______________________________________________________________________
+// MsgSetActionThresholds sets the weights of the keys required to deploy and to manage the keys of the account
+type MsgSetActionThresholds struct {
+	ContractAddress        string           `json:"contract_address" yaml:"contract_address"`
+	AccountAddress         sdk.AccAddress   `json:"account_address" yaml:"account_address"`
+	DeploymentThreshold    uint8            `json:"deployment_threshold" yaml:"deployment_threshold"`
+	KeyManagementThreshold uint8            `json:"key_management_threshold" yaml:"key_management_threshold"`
+	Fee                    string           `json:"fee" yaml:"fee"`
+	Header                 *DeployHeader    `json:"header,omitempty"`
+	AuthorizationKeys      []sdk.AccAddress `json:"authorization_keys,omitempty" yaml:"authorization_keys"`
+}
+
+// NewMsgSetActionThresholds is a constructor function for MsgSetActionThresholds
+func NewMsgSetActionThresholds(
+	tokenContractAddress string,
+	accountAddress sdk.AccAddress,
+	deploymentThreshold, keyManagementThreshold uint8,
+	fee string,
+	authorizationKeys []sdk.AccAddress,
+) MsgSetActionThresholds {
+	return MsgSetActionThresholds{
+		ContractAddress:        tokenContractAddress,
+		AccountAddress:         accountAddress,
+		DeploymentThreshold:    deploymentThreshold,
+		KeyManagementThreshold: keyManagementThreshold,
+		Fee:                    fee,
+		AuthorizationKeys:      authorizationKeys,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetActionThresholds) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetActionThresholds) Type() string { return "executionengine" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetActionThresholds) ValidateBasic() sdk.Error {
+	if msg.AccountAddress.Equals(sdk.AccAddress("")) {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if msg.DeploymentThreshold == 0 || msg.KeyManagementThreshold == 0 {
+		return sdk.ErrUnknownRequest("Thresholds must be positive")
+	}
+	if msg.DeploymentThreshold > msg.KeyManagementThreshold {
+		return sdk.ErrUnknownRequest("Deployment threshold cannot exceed the key management threshold")
+	}
+	return validateAuthorizationKeys(msg.AuthorizationKeys)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetActionThresholds) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetActionThresholds) GetSigners() []sdk.AccAddress {
+	return deploySigners(msg.AccountAddress, msg.AuthorizationKeys, nil)
+}
+
+// GetFee returns the fee paid for the deploy of the message
+func (msg MsgSetActionThresholds) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the message
+func (msg MsgSetActionThresholds) GetHeader() *DeployHeader { return msg.Header }
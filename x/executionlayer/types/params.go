@@ -0,0 +1,183 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/params"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace           = ModuleName
+	DefaultSignedBlocksWindow   = int64(100)
+	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultMaxEvidenceAge       = 60 * 2 * time.Second
+	DefaultMaxValidators        = uint16(100)
+	DefaultGasPrice             = uint64(BASIC_GAS)
+	DefaultMaxDeploySize        = uint32(2 * 1024 * 1024)
+	DefaultMaxSessionArgsLength = uint32(64 * 1024)
+	DefaultExtendedProxy        = false
+)
+
+var (
+	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
+	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
+	DefaultMinFee                  = sdk.ZeroInt()
+	DefaultMinCommissionRate       = sdk.ZeroDec()
+	DefaultMaxCommissionRate       = sdk.OneDec()
+)
+
+// Parameter store keys
+var (
+	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
+	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
+	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
+	KeyMaxValidators           = []byte("MaxValidators")
+	KeyGasPrice                = []byte("GasPrice")
+	KeyMinFee                  = []byte("MinFee")
+	KeyMaxDeploySize           = []byte("MaxDeploySize")
+	KeyMaxSessionArgsLength    = []byte("MaxSessionArgsLength")
+	KeyExtendedProxy           = []byte("ExtendedProxy")
+	KeyMinCommissionRate       = []byte("MinCommissionRate")
+	KeyMaxCommissionRate       = []byte("MaxCommissionRate")
+)
+
+// ParamKeyTable for executionlayer module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params - used for initializing default parameter for executionlayer at genesis
+type Params struct {
+	SignedBlocksWindow      int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`
+	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
+	MaxEvidenceAge          time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`
+	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
+	MaxValidators           uint16        `json:"max_validators" yaml:"max_validators"`                   // maximum number of validators in the validator set
+	GasPrice                uint64        `json:"gas_price" yaml:"gas_price"`                             // price of a gas of deploys in bigsun
+	MinFee                  sdk.Int       `json:"min_fee" yaml:"min_fee"`                                 // minimum fee of a deploy in bigsun
+	MaxDeploySize           uint32        `json:"max_deploy_size" yaml:"max_deploy_size"`                 // maximum size of the wasm code of a deploy in bytes
+	MaxSessionArgsLength    uint32        `json:"max_session_args_length" yaml:"max_session_args_length"` // maximum length of the encoded session args of a deploy in bytes
+	ExtendedProxy           bool          `json:"extended_proxy" yaml:"extended_proxy"`                   // whether the proxy contract implements the methods beyond the client api proxy
+	MinCommissionRate       sdk.Dec       `json:"min_commission_rate" yaml:"min_commission_rate"`         // minimum commission rate of a validator
+	MaxCommissionRate       sdk.Dec       `json:"max_commission_rate" yaml:"max_commission_rate"`         // maximum commission rate of a validator
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
+	maxEvidenceAge time.Duration, slashFractionDoubleSign sdk.Dec, maxValidators uint16,
+	gasPrice uint64, minFee sdk.Int, maxDeploySize, maxSessionArgsLength uint32, extendedProxy bool,
+	minCommissionRate, maxCommissionRate sdk.Dec,
+) Params {
+
+	return Params{
+		SignedBlocksWindow:      signedBlocksWindow,
+		MinSignedPerWindow:      minSignedPerWindow,
+		DowntimeJailDuration:    downtimeJailDuration,
+		MaxEvidenceAge:          maxEvidenceAge,
+		SlashFractionDoubleSign: slashFractionDoubleSign,
+		MaxValidators:           maxValidators,
+		GasPrice:                gasPrice,
+		MinFee:                  minFee,
+		MaxDeploySize:           maxDeploySize,
+		MaxSessionArgsLength:    maxSessionArgsLength,
+		ExtendedProxy:           extendedProxy,
+		MinCommissionRate:       minCommissionRate,
+		MaxCommissionRate:       maxCommissionRate,
+	}
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Execution Layer Params:
+  SignedBlocksWindow:      %d
+  MinSignedPerWindow:      %s
+  DowntimeJailDuration:    %s
+  MaxEvidenceAge:          %s
+  SlashFractionDoubleSign: %s
+  MaxValidators:           %d
+  GasPrice:                %d
+  MinFee:                  %s
+  MaxDeploySize:           %d
+  MaxSessionArgsLength:    %d
+  ExtendedProxy:           %t
+  MinCommissionRate:       %s
+  MaxCommissionRate:       %s`, p.SignedBlocksWindow,
+		p.MinSignedPerWindow, p.DowntimeJailDuration,
+		p.MaxEvidenceAge, p.SlashFractionDoubleSign, p.MaxValidators,
+		p.GasPrice, p.MinFee, p.MaxDeploySize, p.MaxSessionArgsLength, p.ExtendedProxy,
+		p.MinCommissionRate, p.MaxCommissionRate)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{KeySignedBlocksWindow, &p.SignedBlocksWindow},
+		{KeyMinSignedPerWindow, &p.MinSignedPerWindow},
+		{KeyDowntimeJailDuration, &p.DowntimeJailDuration},
+		{KeyMaxEvidenceAge, &p.MaxEvidenceAge},
+		{KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign},
+		{KeyMaxValidators, &p.MaxValidators},
+		{KeyGasPrice, &p.GasPrice},
+		{KeyMinFee, &p.MinFee},
+		{KeyMaxDeploySize, &p.MaxDeploySize},
+		{KeyMaxSessionArgsLength, &p.MaxSessionArgsLength},
+		{KeyExtendedProxy, &p.ExtendedProxy},
+		{KeyMinCommissionRate, &p.MinCommissionRate},
+		{KeyMaxCommissionRate, &p.MaxCommissionRate},
+	}
+}
+
+// DefaultParams returns default parameters for this module
+func DefaultParams() Params {
+	return NewParams(DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
+		DefaultMaxEvidenceAge, DefaultSlashFractionDoubleSign, DefaultMaxValidators,
+		DefaultGasPrice, DefaultMinFee, DefaultMaxDeploySize, DefaultMaxSessionArgsLength, DefaultExtendedProxy,
+		DefaultMinCommissionRate, DefaultMaxCommissionRate)
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	if p.SignedBlocksWindow <= 0 {
+		return fmt.Errorf("signed blocks window must be positive: %d", p.SignedBlocksWindow)
+	}
+	if p.MinSignedPerWindow.IsNil() || p.MinSignedPerWindow.IsNegative() || p.MinSignedPerWindow.GT(sdk.OneDec()) {
+		return fmt.Errorf("min signed per window must be between 0 and 1: %s", p.MinSignedPerWindow)
+	}
+	if p.DowntimeJailDuration <= 0 {
+		return fmt.Errorf("downtime jail duration must be positive: %s", p.DowntimeJailDuration)
+	}
+	if p.MaxEvidenceAge <= 0 {
+		return fmt.Errorf("max evidence age must be positive: %s", p.MaxEvidenceAge)
+	}
+	if p.SlashFractionDoubleSign.IsNil() || p.SlashFractionDoubleSign.IsNegative() || p.SlashFractionDoubleSign.GT(sdk.OneDec()) {
+		return fmt.Errorf("double sign slash fraction must be between 0 and 1: %s", p.SlashFractionDoubleSign)
+	}
+	if p.MaxValidators == 0 {
+		return fmt.Errorf("max validators must be positive")
+	}
+	if p.GasPrice == 0 {
+		return fmt.Errorf("gas price must be positive")
+	}
+	if (p.MinFee == sdk.Int{}) || p.MinFee.IsNegative() {
+		return fmt.Errorf("min fee must not be negative: %s", p.MinFee)
+	}
+	if p.MaxDeploySize == 0 {
+		return fmt.Errorf("max deploy size must be positive")
+	}
+	if p.MaxSessionArgsLength == 0 {
+		return fmt.Errorf("max session args length must be positive")
+	}
+	if p.MinCommissionRate.IsNil() || p.MinCommissionRate.IsNegative() {
+		return fmt.Errorf("min commission rate must not be negative: %s", p.MinCommissionRate)
+	}
+	if p.MaxCommissionRate.IsNil() || p.MaxCommissionRate.LT(p.MinCommissionRate) || p.MaxCommissionRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("max commission rate must be between the min commission rate and 1: %s", p.MaxCommissionRate)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	withDefault := func(modify func(*Params)) Params {
+		params := DefaultParams()
+		modify(&params)
+		return params
+	}
+
+	tests := []struct {
+		name       string
+		params     Params
+		expectPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"zero window", withDefault(func(p *Params) { p.SignedBlocksWindow = 0 }), false},
+		{"nil min signed", withDefault(func(p *Params) { p.MinSignedPerWindow = sdk.Dec{} }), false},
+		{"negative min signed", withDefault(func(p *Params) { p.MinSignedPerWindow = sdk.NewDec(-1) }), false},
+		{"min signed over one", withDefault(func(p *Params) { p.MinSignedPerWindow = sdk.NewDecWithPrec(11, 1) }), false},
+		{"zero jail duration", withDefault(func(p *Params) { p.DowntimeJailDuration = time.Duration(0) }), false},
+		{"zero evidence age", withDefault(func(p *Params) { p.MaxEvidenceAge = time.Duration(0) }), false},
+		{"slash fraction over one", withDefault(func(p *Params) { p.SlashFractionDoubleSign = sdk.NewDec(2) }), false},
+		{"zero max validators", withDefault(func(p *Params) { p.MaxValidators = 0 }), false},
+		{"zero gas price", withDefault(func(p *Params) { p.GasPrice = 0 }), false},
+		{"nil min fee", withDefault(func(p *Params) { p.MinFee = sdk.Int{} }), false},
+		{"negative min fee", withDefault(func(p *Params) { p.MinFee = sdk.NewInt(-1) }), false},
+		{"positive min fee", withDefault(func(p *Params) { p.MinFee = sdk.NewInt(1) }), true},
+		{"zero max deploy size", withDefault(func(p *Params) { p.MaxDeploySize = 0 }), false},
+		{"zero max session args length", withDefault(func(p *Params) { p.MaxSessionArgsLength = 0 }), false},
+		{"nil min commission rate", withDefault(func(p *Params) { p.MinCommissionRate = sdk.Dec{} }), false},
+		{"negative min commission rate", withDefault(func(p *Params) { p.MinCommissionRate = sdk.NewDec(-1) }), false},
+		{"max commission rate over one", withDefault(func(p *Params) { p.MaxCommissionRate = sdk.NewDec(2) }), false},
+		{"max below min commission rate", withDefault(func(p *Params) {
+			p.MinCommissionRate, p.MaxCommissionRate = sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1)
+		}), false},
+		{"fixed commission rate", withDefault(func(p *Params) {
+			p.MinCommissionRate, p.MaxCommissionRate = sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1)
+		}), true},
+	}
+
+	for _, tc := range tests {
+		err := tc.params.Validate()
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/ipc"
+
+	sdk "github.com/hdac-io/friday/types"
+	govtypes "github.com/hdac-io/friday/x/gov/types"
+)
+
+const (
+	// ProposalTypeEngineUpgrade defines the type for a EngineUpgradeProposal
+	ProposalTypeEngineUpgrade = "EngineUpgrade"
+)
+
+// Assert EngineUpgradeProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = EngineUpgradeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeEngineUpgrade)
+	govtypes.RegisterProposalTypeCodec(EngineUpgradeProposal{}, "friday/EngineUpgradeProposal")
+}
+
+// EngineUpgradeProposal schedules a protocol upgrade of the execution engine at a height.
+// The cost table and the installer are optional; the installer is a wasm which
+// applies the upgrades to the system contracts (mint, PoS and standard payment).
+type EngineUpgradeProposal struct {
+	Title           string     `json:"title" yaml:"title"`
+	Description     string     `json:"description" yaml:"description"`
+	Height          int64      `json:"height" yaml:"height"`
+	ProtocolVersion string     `json:"protocol_version" yaml:"protocol_version"`
+	WasmCosts       *WasmCosts `json:"wasm_costs,omitempty" yaml:"wasm_costs,omitempty"`
+	Installer       []byte     `json:"installer,omitempty" yaml:"installer,omitempty"`
+}
+
+// NewEngineUpgradeProposal creates a new engine upgrade proposal.
+func NewEngineUpgradeProposal(title, description string, height int64, protocolVersion string, wasmCosts *WasmCosts, installer []byte) EngineUpgradeProposal {
+	return EngineUpgradeProposal{title, description, height, protocolVersion, wasmCosts, installer}
+}
+
+// GetTitle returns the title of an engine upgrade proposal.
+func (eup EngineUpgradeProposal) GetTitle() string { return eup.Title }
+
+// GetDescription returns the description of an engine upgrade proposal.
+func (eup EngineUpgradeProposal) GetDescription() string { return eup.Description }
+
+// ProposalRoute returns the routing key of an engine upgrade proposal.
+func (eup EngineUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an engine upgrade proposal.
+func (eup EngineUpgradeProposal) ProposalType() string { return ProposalTypeEngineUpgrade }
+
+// ValidateBasic runs basic stateless validity checks
+func (eup EngineUpgradeProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, eup)
+	if err != nil {
+		return err
+	}
+	if eup.Height <= 0 {
+		return ErrInvalidUpgradeHeight(DefaultCodespace, eup.Height)
+	}
+	if _, err := ToProtocolVersion(eup.ProtocolVersion); err != nil {
+		return ErrProtocolVersionParse(DefaultCodespace, eup.ProtocolVersion)
+	}
+	if eup.WasmCosts != nil && eup.WasmCosts.OpcodesDivisor == 0 {
+		return ErrInvalidWasmCosts(DefaultCodespace, "opcodes divisor must not be zero")
+	}
+	return nil
+}
+
+// UpgradePoint returns the upgrade point of the execution engine for the proposal
+func (eup EngineUpgradeProposal) UpgradePoint() (*ipc.ChainSpec_UpgradePoint, error) {
+	protocolVersion, err := ToProtocolVersion(eup.ProtocolVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	upgradePoint := &ipc.ChainSpec_UpgradePoint{
+		ActivationPoint: &ipc.ChainSpec_ActivationPoint{Rank: uint64(eup.Height)},
+		ProtocolVersion: protocolVersion,
+	}
+	if eup.WasmCosts != nil {
+		upgradePoint.NewCosts = toCostTable(*eup.WasmCosts)
+	}
+	if len(eup.Installer) != 0 {
+		upgradePoint.UpgradeInstaller = &ipc.DeployCode{Code: eup.Installer}
+	}
+	return upgradePoint, nil
+}
+
+// String implements the Stringer interface.
+func (eup EngineUpgradeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Engine Upgrade Proposal:
+  Title:            %s
+  Description:      %s
+  Height:           %d
+  Protocol Version: %s
+  Wasm Costs:       %v
+  Installer:        %d bytes
+`, eup.Title, eup.Description, eup.Height, eup.ProtocolVersion, eup.WasmCosts != nil, len(eup.Installer)))
+	return b.String()
+}
+
+// EngineUpgrade is a record of an engine upgrade applied on the chain
+type EngineUpgrade struct {
+	Height          int64  `json:"height" yaml:"height"`
+	ProtocolVersion string `json:"protocol_version" yaml:"protocol_version"`
+	PostStateHash   string `json:"post_state_hash" yaml:"post_state_hash"`
+}
+
+// NewEngineUpgrade returns a record of an engine upgrade
+func NewEngineUpgrade(height int64, protocolVersion state.ProtocolVersion, postStateHash []byte) EngineUpgrade {
+	return EngineUpgrade{
+		Height:          height,
+		ProtocolVersion: ProtocolVersionString(protocolVersion),
+		PostStateHash:   hex.EncodeToString(postStateHash),
+	}
+}
+
+// String implements the Stringer interface.
+func (eu EngineUpgrade) String() string {
+	return fmt.Sprintf(`Engine Upgrade:
+  Height:           %d
+  Protocol Version: %s
+  Post State Hash:  %s`, eu.Height, eu.ProtocolVersion, eu.PostStateHash)
+}
+
+// ProtocolVersionString returns the protocol version as "major.minor.patch"
+func ProtocolVersionString(protocolVersion state.ProtocolVersion) string {
+	return fmt.Sprintf("%d.%d.%d", protocolVersion.GetMajor(), protocolVersion.GetMinor(), protocolVersion.GetPatch())
+}
+
+// ProtocolVersionLess returns whether the protocol version a precedes b
+func ProtocolVersionLess(a, b state.ProtocolVersion) bool {
+	if a.GetMajor() != b.GetMajor() {
+		return a.GetMajor() < b.GetMajor()
+	}
+	if a.GetMinor() != b.GetMinor() {
+		return a.GetMinor() < b.GetMinor()
+	}
+	return a.GetPatch() < b.GetPatch()
+}
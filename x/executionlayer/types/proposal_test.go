@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/protobuf/io/casperlabs/casper/consensus/state"
+	"github.com/stretchr/testify/require"
+)
+
+var testWasmCosts = WasmCosts{
+	Regular:           1,
+	DivMultiplier:     16,
+	MulMultiplier:     4,
+	MemMultiplier:     2,
+	MemInitialPages:   4096,
+	MemGrowPerPage:    8192,
+	MemCopyPerByte:    1,
+	MaxStackHeight:    65536,
+	OpcodesMultiplier: 3,
+	OpcodesDivisor:    8,
+}
+
+func TestEngineUpgradeProposalValidateBasic(t *testing.T) {
+	wasmCosts := testWasmCosts
+	zeroDivisor := wasmCosts
+	zeroDivisor.OpcodesDivisor = 0
+
+	cases := []struct {
+		name       string
+		proposal   EngineUpgradeProposal
+		expectPass bool
+	}{
+		{"version only", NewEngineUpgradeProposal("title", "description", 10, "1.1.0", nil, nil), true},
+		{"costs and installer", NewEngineUpgradeProposal("title", "description", 10, "2.0.0", &wasmCosts, []byte{0, 1}), true},
+		{"no title", NewEngineUpgradeProposal("", "description", 10, "1.1.0", nil, nil), false},
+		{"zero height", NewEngineUpgradeProposal("title", "description", 0, "1.1.0", nil, nil), false},
+		{"invalid version", NewEngineUpgradeProposal("title", "description", 10, "1.1", nil, nil), false},
+		{"zero divisor", NewEngineUpgradeProposal("title", "description", 10, "1.1.0", &zeroDivisor, nil), false},
+	}
+
+	for _, tc := range cases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expectPass {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+}
+
+func TestEngineUpgradeProposalUpgradePoint(t *testing.T) {
+	upgradePoint, err := NewEngineUpgradeProposal("title", "description", 10, "1.2.3", nil, nil).UpgradePoint()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), upgradePoint.GetActivationPoint().GetRank())
+	require.Equal(t, "1.2.3", ProtocolVersionString(*upgradePoint.GetProtocolVersion()))
+	require.Nil(t, upgradePoint.GetNewCosts())
+	require.Nil(t, upgradePoint.GetUpgradeInstaller())
+
+	wasmCosts := testWasmCosts
+	upgradePoint, err = NewEngineUpgradeProposal("title", "description", 10, "1.2.3", &wasmCosts, []byte{0, 1}).UpgradePoint()
+	require.NoError(t, err)
+	require.Equal(t, wasmCosts.OpcodesDivisor, upgradePoint.GetNewCosts().GetWasm().GetOpcodesDiv())
+	require.Equal(t, []byte{0, 1}, upgradePoint.GetUpgradeInstaller().GetCode())
+}
+
+func TestProtocolVersionLess(t *testing.T) {
+	v := func(major, minor, patch uint32) state.ProtocolVersion {
+		return state.ProtocolVersion{Major: major, Minor: minor, Patch: patch}
+	}
+	require.True(t, ProtocolVersionLess(v(1, 0, 0), v(1, 0, 1)))
+	require.True(t, ProtocolVersionLess(v(1, 9, 9), v(2, 0, 0)))
+	require.True(t, ProtocolVersionLess(v(1, 0, 9), v(1, 1, 0)))
+	require.False(t, ProtocolVersionLess(v(1, 0, 0), v(1, 0, 0)))
+	require.False(t, ProtocolVersionLess(v(2, 0, 0), v(1, 9, 9)))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/hdac-io/friday/types"
 )
@@ -177,3 +178,59 @@ func NewQueryGetCommission(address sdk.AccAddress) QueryGetCommission {
 func (q QueryGetCommission) String() string {
 	return fmt.Sprintf("Query public key or readable name: %s", q.Address)
 }
+
+// QuerySimulateParams payload for the simulation of a message on the execution engine
+type QuerySimulateParams struct {
+	Msg sdk.Msg `json:"msg"`
+}
+
+func NewQuerySimulateParams(msg sdk.Msg) QuerySimulateParams {
+	return QuerySimulateParams{
+		Msg: msg,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/%s/querycontract'
+type QueryContractParams struct {
+	Name string `json:"name"`
+}
+
+func NewQueryContractParams(name string) QueryContractParams {
+	return QueryContractParams{
+		Name: name,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/%s/querycontracts'
+// Every registered contract is listed if the deployer is empty.
+type QueryContractsParams struct {
+	DeployerAddr sdk.AccAddress `json:"deployer_address"`
+}
+
+func NewQueryContractsParams(deployerAddr sdk.AccAddress) QueryContractsParams {
+	return QueryContractsParams{
+		DeployerAddr: deployerAddr,
+	}
+}
+
+// QueryEngineUpgradeResult is the result of 'custom/%s/queryupgrade'
+type QueryEngineUpgradeResult struct {
+	ProtocolVersion string                 `json:"protocol_version"`
+	Scheduled       *EngineUpgradeProposal `json:"scheduled"`
+	Applied         []EngineUpgrade        `json:"applied"`
+}
+
+// String implements fmt.Stringer
+func (r QueryEngineUpgradeResult) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Protocol Version: %s\n", r.ProtocolVersion))
+	if r.Scheduled != nil {
+		b.WriteString(r.Scheduled.String())
+	}
+	for _, upgrade := range r.Applied {
+		b.WriteString(upgrade.String() + "\n")
+	}
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,187 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+// kinds of deploy errors
+const (
+	DeployErrorNone                = ""
+	DeployErrorPreconditionFailure = "precondition_failure"
+	DeployErrorGas                 = "gas_error"
+	DeployErrorExec                = "exec_error"
+	DeployErrorMissingParent       = "missing_parent"
+	DeployErrorUnknown             = "unknown"
+	DeployErrorEngine              = "engine_error"
+	DeployErrorInvalidArgs         = "invalid_args"
+	DeployErrorFeeTooLow           = "fee_too_low"
+	DeployErrorDeployTooLarge      = "deploy_too_large"
+	DeployErrorSessionArgsTooLong  = "session_args_too_long"
+)
+
+// DeployResult is the result of a deploy on the execution engine.
+// It is returned as the data of executionlayer messages.
+type DeployResult struct {
+	DeployHash   string            `json:"deploy_hash" yaml:"deploy_hash"`
+	Success      bool              `json:"success" yaml:"success"`
+	ErrorKind    string            `json:"error_kind" yaml:"error_kind"`
+	ErrorMessage string            `json:"error_message" yaml:"error_message"`
+	Codespace    sdk.CodespaceType `json:"codespace" yaml:"codespace"`
+	Code         sdk.CodeType      `json:"code" yaml:"code"`
+	GasCost      uint64            `json:"gas_cost" yaml:"gas_cost"`
+	EffectsCount int               `json:"effects_count" yaml:"effects_count"`
+}
+
+// NewDeployResult returns a new DeployResult
+// The codespace and code are those of the error kind.
+func NewDeployResult(deployHash string, errorKind string, errorMessage string, gasCost uint64, effectsCount int) DeployResult {
+	result := DeployResult{
+		DeployHash:   deployHash,
+		Success:      errorKind == DeployErrorNone,
+		ErrorKind:    errorKind,
+		ErrorMessage: errorMessage,
+		GasCost:      gasCost,
+		EffectsCount: effectsCount,
+	}
+	if err := result.Err(); err != nil {
+		result.Codespace = err.Codespace()
+		result.Code = err.Code()
+	}
+	return result
+}
+
+// Err returns the error of a failed deploy, or nil if the deploy succeeded
+func (r DeployResult) Err() sdk.Error {
+	switch r.ErrorKind {
+	case DeployErrorNone:
+		return nil
+	case DeployErrorPreconditionFailure:
+		return ErrGRpcExecuteDeployPreconditionFailure(DefaultCodespace, r.ErrorMessage)
+	case DeployErrorGas:
+		return ErrGRpcExecuteDeployGasError(DefaultCodespace)
+	case DeployErrorExec:
+		return ErrGRpcExecuteDeployExecError(DefaultCodespace, r.ErrorMessage)
+	case DeployErrorMissingParent:
+		return ErrGRpcExecuteMissingParent(DefaultCodespace, r.ErrorMessage)
+	case DeployErrorEngine:
+		return ErrGRpcExecuteFailure(DefaultCodespace, r.ErrorMessage)
+	case DeployErrorInvalidArgs:
+		return ErrInvalidDeployArgs(DefaultCodespace, r.ErrorMessage)
+	case DeployErrorFeeTooLow:
+		return ErrFeeTooLow(DefaultCodespace, r.ErrorMessage)
+	case DeployErrorDeployTooLarge:
+		return ErrDeployTooLarge(DefaultCodespace, r.ErrorMessage)
+	case DeployErrorSessionArgsTooLong:
+		return ErrSessionArgsTooLong(DefaultCodespace, r.ErrorMessage)
+	default:
+		return ErrGRpcExecuteUnknownResult(DefaultCodespace, r.ErrorMessage)
+	}
+}
+
+// String implements fmt.Stringer
+func (r DeployResult) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Deploy Result:
+  Deploy Hash:   %s
+  Success:       %t
+  Error Kind:    %s
+  Error Message: %s
+  Codespace:     %s
+  Code:          %d
+  Gas Cost:      %d
+  Effects Count: %d`,
+		r.DeployHash, r.Success, r.ErrorKind, r.ErrorMessage, r.Codespace, r.Code, r.GasCost, r.EffectsCount))
+}
+
+// DecodeDeployResults decodes the deploy results from the data of a tx.
+// Messages without a deploy do not have a result.
+func DecodeDeployResults(data []byte) ([]DeployResult, error) {
+	results := []DeployResult{}
+	for len(data) > 0 {
+		size, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < size {
+			return nil, fmt.Errorf("invalid deploy result data")
+		}
+
+		var result DeployResult
+		if err := ModuleCdc.UnmarshalBinaryBare(data[n:n+int(size)], &result); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+		data = data[n+int(size):]
+	}
+	return results, nil
+}
+
+// SuggestedFee returns the fee in bigsun which pays for the gas cost of a deploy at the gas price
+func SuggestedFee(gasCost uint64, gasPrice uint64) string {
+	fee := new(big.Int).SetUint64(gasCost)
+	return fee.Mul(fee, new(big.Int).SetUint64(gasPrice)).String()
+}
+
+// EstimatedCost returns the max gas cost which the fee of a deploy pays for at the gas price.
+// A deploy running out of the gas fails, so its gas cost never exceeds the estimate.
+func EstimatedCost(fee string, gasPrice uint64) sdk.Uint {
+	amount, err := sdk.ParseUint(fee)
+	if err != nil || gasPrice == 0 {
+		return sdk.ZeroUint()
+	}
+	return amount.QuoUint64(gasPrice)
+}
+
+// SimulateResult is the result of a message simulated on the execution engine
+type SimulateResult struct {
+	Success      bool              `json:"success" yaml:"success"`
+	Codespace    sdk.CodespaceType `json:"codespace" yaml:"codespace"`
+	Code         sdk.CodeType      `json:"code" yaml:"code"`
+	Error        string            `json:"error" yaml:"error"`
+	GasCost      uint64            `json:"gas_cost" yaml:"gas_cost"`
+	SuggestedFee string            `json:"suggested_fee" yaml:"suggested_fee"`
+	Deploys      []DeployResult    `json:"deploys" yaml:"deploys"`
+}
+
+// NewSimulateResult returns the SimulateResult of the result of a simulated message
+func NewSimulateResult(res sdk.Result, gasPrice uint64) (SimulateResult, error) {
+	deploys, err := DecodeDeployResults(res.Data)
+	if err != nil {
+		return SimulateResult{}, err
+	}
+
+	result := SimulateResult{
+		Success:      res.IsOK(),
+		Codespace:    res.Codespace,
+		Code:         res.Code,
+		GasCost:      res.GasUsed,
+		SuggestedFee: SuggestedFee(res.GasUsed, gasPrice),
+		Deploys:      deploys,
+	}
+	if !res.IsOK() {
+		// the log of a failed message is the json of its error
+		var log struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal([]byte(res.Log), &log) == nil && log.Message != "" {
+			result.Error = log.Message
+		} else {
+			result.Error = res.Log
+		}
+	}
+	return result, nil
+}
+
+// String implements fmt.Stringer
+func (r SimulateResult) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Simulate Result:
+  Success:       %t
+  Codespace:     %s
+  Code:          %d
+  Error:         %s
+  Gas Cost:      %d
+  Suggested Fee: %s`,
+		r.Success, r.Codespace, r.Code, r.Error, r.GasCost, r.SuggestedFee))
+}
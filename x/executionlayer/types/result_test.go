@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeDeployResults(t *testing.T) {
+	success := NewDeployResult("00ff", DeployErrorNone, "", 100000000, 3)
+	failure := NewDeployResult("ff00", DeployErrorExec, "Insufficient funds", 100000000, 1)
+	require.True(t, success.Success)
+	require.False(t, failure.Success)
+
+	data := append(ModuleCdc.MustMarshalBinaryLengthPrefixed(success), ModuleCdc.MustMarshalBinaryLengthPrefixed(failure)...)
+	results, err := DecodeDeployResults(data)
+	require.NoError(t, err)
+	require.Equal(t, []DeployResult{success, failure}, results)
+
+	results, err = DecodeDeployResults(nil)
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	_, err = DecodeDeployResults(data[:len(data)-1])
+	require.Error(t, err)
+}
+
+func TestDeployResultErr(t *testing.T) {
+	require.Nil(t, NewDeployResult("00ff", DeployErrorNone, "", 100000000, 3).Err())
+
+	tests := []struct {
+		errorKind string
+		code      sdk.CodeType
+	}{
+		{DeployErrorPreconditionFailure, CodeGRpcExecuteDeployPreconditionFailure},
+		{DeployErrorGas, CodeGRpcExecuteDeployGasError},
+		{DeployErrorExec, CodeGRpcExecuteDeployExecError},
+		{DeployErrorMissingParent, CodeGRpcExecuteMissingParent},
+		{DeployErrorEngine, CodeGRpcExecuteFailure},
+		{DeployErrorInvalidArgs, CodeInvalidInput},
+		{DeployErrorUnknown, CodeGRpcExecuteUnknownResult},
+	}
+	for _, tc := range tests {
+		result := NewDeployResult("00ff", tc.errorKind, "message", 0, 0)
+		require.Equal(t, DefaultCodespace, result.Codespace, tc.errorKind)
+		require.Equal(t, tc.code, result.Code, tc.errorKind)
+		require.Equal(t, tc.code, result.Err().Code(), tc.errorKind)
+	}
+}
+
+func TestEstimatedCost(t *testing.T) {
+	require.Equal(t, sdk.NewUint(1000), EstimatedCost("10000", 10))
+	require.Equal(t, sdk.NewUint(1000), EstimatedCost("10009", 10))
+	require.Equal(t, sdk.ZeroUint(), EstimatedCost("-1", 10))
+	require.Equal(t, sdk.ZeroUint(), EstimatedCost("10000", 0))
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+// SlashRecord is an entry of the slash history.
+// It records the stake which was slashed from a validator and its delegators for an infraction.
+type SlashRecord struct {
+	Validator        sdk.AccAddress  `json:"validator" yaml:"validator"`
+	ConsAddress      sdk.ConsAddress `json:"cons_address" yaml:"cons_address"`
+	InfractionHeight int64           `json:"infraction_height" yaml:"infraction_height"`
+	Height           int64           `json:"height" yaml:"height"`
+	Time             time.Time       `json:"time" yaml:"time"`
+	Fraction         sdk.Dec         `json:"fraction" yaml:"fraction"`
+	Amount           string          `json:"amount" yaml:"amount"`
+	Reason           string          `json:"reason" yaml:"reason"`
+}
+
+// NewSlashRecord returns an entry of the slash history
+func NewSlashRecord(
+	validator sdk.AccAddress, consAddr sdk.ConsAddress, infractionHeight, height int64,
+	slashTime time.Time, fraction sdk.Dec, amount string, reason string,
+) SlashRecord {
+
+	return SlashRecord{
+		Validator:        validator,
+		ConsAddress:      consAddr,
+		InfractionHeight: infractionHeight,
+		Height:           height,
+		Time:             slashTime,
+		Fraction:         fraction,
+		Amount:           amount,
+		Reason:           reason,
+	}
+}
+
+// implement fmt.Stringer
+func (r SlashRecord) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Validator:         %s
+Consensus address: %s
+Infraction height: %d
+Height:            %d
+Time:              %v
+Fraction:          %s
+Amount:            %s
+Reason:            %s`, r.Validator, r.ConsAddress, r.InfractionHeight, r.Height, r.Time, r.Fraction, r.Amount, r.Reason))
+}
+
+// SlashRecords is a list of slash records
+type SlashRecords []SlashRecord
+
+// implement fmt.Stringer
+func (r SlashRecords) String() string {
+	out := []string{}
+	for _, record := range r {
+		out = append(out, record.String())
+	}
+	return strings.Join(out, "\n\n")
+}
@@ -0,0 +1,460 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/storedvalue"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+// MaxStateDepth is the deepest level of named keys which a state query walks
+const MaxStateDepth = 5
+
+// type names of a StoredState
+const (
+	StoredTypeAccount  = "account"
+	StoredTypeContract = "contract"
+	StoredTypeCLValue  = "cl_value"
+)
+
+// QueryStateParams payload for a decoded EE state query.
+// The named keys of accounts and contracts are walked to Depth levels.
+type QueryStateParams struct {
+	KeyType string `json:"key_type"`
+	KeyData string `json:"key_data"`
+	Path    string `json:"path"`
+	Depth   int    `json:"depth"`
+}
+
+// implement fmt.Stringer
+func (q QueryStateParams) String() string {
+	return fmt.Sprintf("Key type: %s\nKey data: %s\nPath: %s\nDepth: %d", q.KeyType, q.KeyData, q.Path, q.Depth)
+}
+
+// StoredState is the human readable form of a stored value of the execution engine
+type StoredState struct {
+	Type     string         `json:"type"`
+	Account  *AccountState  `json:"account,omitempty"`
+	Contract *ContractState `json:"contract,omitempty"`
+	CLValue  *CLValueState  `json:"cl_value,omitempty"`
+}
+
+// AccountState is the human readable form of an account
+type AccountState struct {
+	Address          string                `json:"address"`
+	MainPurse        string                `json:"main_purse"`
+	Balance          string                `json:"balance,omitempty"`
+	AssociatedKeys   []AssociatedKeyState  `json:"associated_keys"`
+	ActionThresholds ActionThresholdsState `json:"action_thresholds"`
+	NamedKeys        []NamedKeyState       `json:"named_keys"`
+}
+
+// AssociatedKeyState is an account which may sign for an account with its weight
+type AssociatedKeyState struct {
+	Address string `json:"address"`
+	Weight  uint32 `json:"weight"`
+}
+
+// ActionThresholdsState are the weights which the signatures of a deploy of an account need
+type ActionThresholdsState struct {
+	Deployment    uint32 `json:"deployment"`
+	KeyManagement uint32 `json:"key_management"`
+}
+
+// ContractState is the human readable form of a contract
+type ContractState struct {
+	ProtocolVersion string          `json:"protocol_version"`
+	BodySize        int             `json:"body_size"`
+	NamedKeys       []NamedKeyState `json:"named_keys"`
+}
+
+// NamedKeyState is a named key, and the value it points to when the named keys are walked
+type NamedKeyState struct {
+	Name  string       `json:"name"`
+	Key   KeyState     `json:"key"`
+	Value *StoredState `json:"value,omitempty"`
+	Error string       `json:"error,omitempty"`
+}
+
+// KeyState is a key in the form of the key type and data of a query
+type KeyState struct {
+	KeyType string `json:"key_type"`
+	KeyData string `json:"key_data"`
+}
+
+// CLValueState is a decoded CLValue. Bytes are the serialized value in hex.
+// Value is empty when the type is not supported.
+type CLValueState struct {
+	CLType string      `json:"cl_type"`
+	Value  interface{} `json:"value"`
+	Bytes  string      `json:"bytes"`
+}
+
+// NewStoredState returns the human readable form of a stored value. Named keys are not walked.
+func NewStoredState(value storedvalue.StoredValue) StoredState {
+	switch value.Type {
+	case storedvalue.TYPE_ACCOUNT:
+		account := value.Account
+		res := &AccountState{
+			Address:   sdk.AccAddress(account.PublicKey).String(),
+			MainPurse: sdk.ContractUrefAddress(account.MainPurse.Address).String(),
+			ActionThresholds: ActionThresholdsState{
+				Deployment:    account.ActionThresholds.DeploymentThreshold,
+				KeyManagement: account.ActionThresholds.KeyManagementThreshold,
+			},
+			AssociatedKeys: []AssociatedKeyState{},
+			NamedKeys:      newNamedKeyStates(account.NamedKeys),
+		}
+		for _, associatedKey := range account.AssociatedKeys {
+			res.AssociatedKeys = append(res.AssociatedKeys, AssociatedKeyState{
+				Address: sdk.AccAddress(associatedKey.PublicKey).String(),
+				Weight:  associatedKey.Weight,
+			})
+		}
+		return StoredState{Type: StoredTypeAccount, Account: res}
+
+	case storedvalue.TYPE_CONTRACT:
+		contract := value.Contract
+		version := contract.ProtocolVersion
+		return StoredState{Type: StoredTypeContract, Contract: &ContractState{
+			ProtocolVersion: fmt.Sprintf("%d.%d.%d", version.Major, version.Minor, version.Patch),
+			BodySize:        len(contract.Body),
+			NamedKeys:       newNamedKeyStates(contract.NamedKeys),
+		}}
+
+	default:
+		res := NewCLValueState(value.ClValue)
+		return StoredState{Type: StoredTypeCLValue, CLValue: &res}
+	}
+}
+
+// implement fmt.Stringer
+func (s StoredState) String() string {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return s.Type
+	}
+	return string(bz)
+}
+
+// NamedKeys returns the named keys of an account or a contract
+func (s StoredState) NamedKeys() []NamedKeyState {
+	switch {
+	case s.Account != nil:
+		return s.Account.NamedKeys
+	case s.Contract != nil:
+		return s.Contract.NamedKeys
+	}
+	return nil
+}
+
+func newNamedKeyStates(namedKeys storedvalue.NamedKeys) []NamedKeyState {
+	res := []NamedKeyState{}
+	for _, namedKey := range namedKeys {
+		res = append(res, NamedKeyState{Name: namedKey.Name, Key: newKeyState(namedKey.Key)})
+	}
+	return res
+}
+
+func newKeyState(key storedvalue.Key) KeyState {
+	switch key.KeyID {
+	case storedvalue.KEY_ID_ACCOUNT:
+		return KeyState{KeyType: ADDRESS, KeyData: sdk.AccAddress(key.Account.PublicKey).String()}
+	case storedvalue.KEY_ID_HASH:
+		return KeyState{KeyType: HASH, KeyData: sdk.ContractHashAddress(key.Hash).String()}
+	case storedvalue.KEY_ID_UREF:
+		return KeyState{KeyType: UREF, KeyData: sdk.ContractUrefAddress(key.Uref.Address).String()}
+	default:
+		return KeyState{KeyType: LOCAL, KeyData: hex.EncodeToString(key.Local)}
+	}
+}
+
+// NewCLValueState decodes a CLValue
+func NewCLValueState(value storedvalue.CLValue) CLValueState {
+	res := CLValueState{Bytes: hex.EncodeToString(value.Bytes)}
+
+	tags := &clTagReader{tags: value.Tags}
+	clType, err := tags.clType()
+	if err != nil || len(tags.tags) > 0 {
+		res.CLType = "unknown"
+		return res
+	}
+	res.CLType = clType.String()
+
+	r := &abiReader{bz: value.Bytes}
+	decoded, err := clType.decode(r)
+	if err == nil && len(r.bz) == 0 {
+		res.Value = decoded
+	}
+	return res
+}
+
+// clTypeTree is a CLType given by the type tags of a stored CLValue
+type clTypeTree struct {
+	tag   storedvalue.CL_TYPE_TAG
+	inner []*clTypeTree
+}
+
+var clTypeNames = map[storedvalue.CL_TYPE_TAG]string{
+	storedvalue.TAG_BOOL:       "Bool",
+	storedvalue.TAG_I32:        "I32",
+	storedvalue.TAG_I64:        "I64",
+	storedvalue.TAG_U8:         "U8",
+	storedvalue.TAG_U32:        "U32",
+	storedvalue.TAG_U64:        "U64",
+	storedvalue.TAG_U128:       "U128",
+	storedvalue.TAG_U256:       "U256",
+	storedvalue.TAG_U512:       "U512",
+	storedvalue.TAG_UNIT:       "Unit",
+	storedvalue.TAG_STRING:     "String",
+	storedvalue.TAG_KEY:        "Key",
+	storedvalue.TAG_UREF:       "URef",
+	storedvalue.TAG_OPTION:     "Option",
+	storedvalue.TAG_LIST:       "List",
+	storedvalue.TAG_FIXED_LIST: "FixedList",
+	storedvalue.TAG_RESULT:     "Result",
+	storedvalue.TAG_MAP:        "Map",
+	storedvalue.TAG_TUPLE1:     "Tuple1",
+	storedvalue.TAG_TUPLE2:     "Tuple2",
+	storedvalue.TAG_TUPLE3:     "Tuple3",
+	storedvalue.TAG_ANY:        "Any",
+}
+
+func (t *clTypeTree) String() string {
+	if len(t.inner) == 0 {
+		return clTypeNames[t.tag]
+	}
+	inner := []string{}
+	for _, i := range t.inner {
+		inner = append(inner, i.String())
+	}
+	return fmt.Sprintf("%s<%s>", clTypeNames[t.tag], strings.Join(inner, ", "))
+}
+
+type clTagReader struct {
+	tags []storedvalue.CL_TYPE_TAG
+}
+
+func (r *clTagReader) clType() (*clTypeTree, error) {
+	if len(r.tags) == 0 {
+		return nil, fmt.Errorf("unexpected end of type tags")
+	}
+	res := &clTypeTree{tag: r.tags[0]}
+	r.tags = r.tags[1:]
+
+	count := 0
+	switch res.tag {
+	case storedvalue.TAG_OPTION, storedvalue.TAG_LIST, storedvalue.TAG_FIXED_LIST, storedvalue.TAG_TUPLE1:
+		count = 1
+	case storedvalue.TAG_RESULT, storedvalue.TAG_MAP, storedvalue.TAG_TUPLE2:
+		count = 2
+	case storedvalue.TAG_TUPLE3:
+		count = 3
+	default:
+		if _, ok := clTypeNames[res.tag]; !ok {
+			return nil, fmt.Errorf("unknown type tag %d", res.tag)
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		inner, err := r.clType()
+		if err != nil {
+			return nil, err
+		}
+		res.inner = append(res.inner, inner)
+	}
+	return res, nil
+}
+
+// decode returns the value in a json friendly form.
+// Big integers are decimal strings, bytes are hex, and keys are in the form of KeyState.
+func (t *clTypeTree) decode(r *abiReader) (interface{}, error) {
+	switch t.tag {
+	case storedvalue.TAG_BOOL:
+		bz, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		return bz[0] == 1, nil
+	case storedvalue.TAG_I32:
+		bz, err := r.next(4)
+		if err != nil {
+			return nil, err
+		}
+		return int32(binary.LittleEndian.Uint32(bz)), nil
+	case storedvalue.TAG_I64:
+		bz, err := r.next(8)
+		if err != nil {
+			return nil, err
+		}
+		return int64(binary.LittleEndian.Uint64(bz)), nil
+	case storedvalue.TAG_U8:
+		bz, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		return bz[0], nil
+	case storedvalue.TAG_U32:
+		bz, err := r.next(4)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.Uint32(bz), nil
+	case storedvalue.TAG_U64:
+		bz, err := r.next(8)
+		if err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.Uint64(bz), nil
+	case storedvalue.TAG_U128, storedvalue.TAG_U256, storedvalue.TAG_U512:
+		length, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := r.next(int(length[0]))
+		if err != nil {
+			return nil, err
+		}
+		be := make([]byte, len(bz))
+		for i := range bz {
+			be[len(bz)-1-i] = bz[i]
+		}
+		return new(big.Int).SetBytes(be).String(), nil
+	case storedvalue.TAG_UNIT:
+		return nil, nil
+	case storedvalue.TAG_STRING:
+		length, err := r.size()
+		if err != nil {
+			return nil, err
+		}
+		bz, err := r.next(length)
+		if err != nil {
+			return nil, err
+		}
+		return string(bz), nil
+	case storedvalue.TAG_KEY:
+		return decodeKeyState(r)
+	case storedvalue.TAG_UREF:
+		address, err := r.next(abiAddressLength + 1)
+		if err != nil {
+			return nil, err
+		}
+		return KeyState{KeyType: UREF, KeyData: sdk.ContractUrefAddress(address[:abiAddressLength]).String()}, nil
+	case storedvalue.TAG_OPTION:
+		some, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		if some[0] == 0 {
+			return nil, nil
+		}
+		return t.inner[0].decode(r)
+	case storedvalue.TAG_LIST:
+		count, err := r.size()
+		if err != nil {
+			return nil, err
+		}
+		if t.inner[0].tag == storedvalue.TAG_U8 {
+			bz, err := r.next(count)
+			if err != nil {
+				return nil, err
+			}
+			return hex.EncodeToString(bz), nil
+		}
+		return t.inner[0].decodeList(r, count)
+	case storedvalue.TAG_FIXED_LIST:
+		// the length is not kept in the type tags, so the list takes all the remaining bytes
+		if t.inner[0].tag == storedvalue.TAG_U8 {
+			bz, _ := r.next(len(r.bz))
+			return hex.EncodeToString(bz), nil
+		}
+		return t.inner[0].decodeList(r, -1)
+	case storedvalue.TAG_RESULT:
+		ok, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		if ok[0] == 1 {
+			value, err := t.inner[0].decode(r)
+			return map[string]interface{}{"ok": value}, err
+		}
+		value, err := t.inner[1].decode(r)
+		return map[string]interface{}{"err": value}, err
+	case storedvalue.TAG_MAP:
+		count, err := r.size()
+		if err != nil {
+			return nil, err
+		}
+		res := []map[string]interface{}{}
+		for i := 0; i < count; i++ {
+			key, err := t.inner[0].decode(r)
+			if err != nil {
+				return nil, err
+			}
+			value, err := t.inner[1].decode(r)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, map[string]interface{}{"key": key, "value": value})
+		}
+		return res, nil
+	case storedvalue.TAG_TUPLE1, storedvalue.TAG_TUPLE2, storedvalue.TAG_TUPLE3:
+		res := []interface{}{}
+		for _, inner := range t.inner {
+			value, err := inner.decode(r)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, value)
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t.String())
+	}
+}
+
+// decodeList decodes count values, or values until the end of the bytes if count is negative
+func (t *clTypeTree) decodeList(r *abiReader, count int) ([]interface{}, error) {
+	res := []interface{}{}
+	for i := 0; i != count && (count >= 0 || len(r.bz) > 0); i++ {
+		value, err := t.decode(r)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, value)
+	}
+	return res, nil
+}
+
+func decodeKeyState(r *abiReader) (KeyState, error) {
+	tag, err := r.next(1)
+	if err != nil {
+		return KeyState{}, err
+	}
+	address, err := r.next(abiAddressLength)
+	if err != nil {
+		return KeyState{}, err
+	}
+
+	switch tag[0] {
+	case abiKeyAccount:
+		return KeyState{KeyType: ADDRESS, KeyData: sdk.AccAddress(address).String()}, nil
+	case abiKeyHash:
+		return KeyState{KeyType: HASH, KeyData: sdk.ContractHashAddress(address).String()}, nil
+	case abiKeyURef:
+		if _, err := r.next(1); err != nil {
+			return KeyState{}, err
+		}
+		return KeyState{KeyType: UREF, KeyData: sdk.ContractUrefAddress(address).String()}, nil
+	case abiKeyLocal:
+		return KeyState{KeyType: LOCAL, KeyData: hex.EncodeToString(address)}, nil
+	default:
+		return KeyState{}, fmt.Errorf("invalid key tag %d", tag[0])
+	}
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/storedvalue"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+func TestNewCLValueState(t *testing.T) {
+	address := bytes.Repeat([]byte{5}, 32)
+	cases := []struct {
+		name     string
+		bytes    []byte
+		tags     []storedvalue.CL_TYPE_TAG
+		clType   string
+		expected interface{}
+	}{
+		{"bool", []byte{1}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_BOOL}, "Bool", true},
+		{"u64", []byte{1, 1, 0, 0, 0, 0, 0, 0}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_U64}, "U64", uint64(257)},
+		{"u512", []byte{2, 0, 1}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_U512}, "U512", "256"},
+		{"string", []byte{2, 0, 0, 0, 'h', 'i'}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_STRING}, "String", "hi"},
+		{"unit", []byte{}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_UNIT}, "Unit", nil},
+		{"hash key", append([]byte{abiKeyHash}, address...), []storedvalue.CL_TYPE_TAG{storedvalue.TAG_KEY}, "Key",
+			KeyState{KeyType: HASH, KeyData: sdk.ContractHashAddress(address).String()}},
+		{"uref", append(append([]byte{}, address...), 7), []storedvalue.CL_TYPE_TAG{storedvalue.TAG_UREF}, "URef",
+			KeyState{KeyType: UREF, KeyData: sdk.ContractUrefAddress(address).String()}},
+		{"some", []byte{1, 1, 9}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_OPTION, storedvalue.TAG_U512}, "Option<U512>", "9"},
+		{"none", []byte{0}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_OPTION, storedvalue.TAG_U512}, "Option<U512>", nil},
+		{"bytes", []byte{2, 0, 0, 0, 0xab, 0xcd}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_LIST, storedvalue.TAG_U8}, "List<U8>", "abcd"},
+		{"list", []byte{2, 0, 0, 0, 1, 0}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_LIST, storedvalue.TAG_BOOL}, "List<Bool>", []interface{}{true, false}},
+		{"map", []byte{1, 0, 0, 0, 1, 0, 0, 0, 'a', 1, 3}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_MAP, storedvalue.TAG_STRING, storedvalue.TAG_U512}, "Map<String, U512>",
+			[]map[string]interface{}{{"key": "a", "value": "3"}}},
+		{"tuple", []byte{1, 5, 0, 0, 0}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_TUPLE2, storedvalue.TAG_BOOL, storedvalue.TAG_U32}, "Tuple2<Bool, U32>",
+			[]interface{}{true, uint32(5)}},
+	}
+
+	for _, tc := range cases {
+		res := NewCLValueState(storedvalue.NewClValue(tc.bytes, tc.tags))
+		require.Equal(t, tc.clType, res.CLType, tc.name)
+		require.Equal(t, tc.expected, res.Value, tc.name)
+		require.Equal(t, hex.EncodeToString(tc.bytes), res.Bytes, tc.name)
+	}
+
+	// trailing bytes and incomplete type tags leave the value undecoded
+	res := NewCLValueState(storedvalue.NewClValue([]byte{1, 2}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_BOOL}))
+	require.Equal(t, "Bool", res.CLType)
+	require.Nil(t, res.Value)
+	res = NewCLValueState(storedvalue.NewClValue([]byte{0}, []storedvalue.CL_TYPE_TAG{storedvalue.TAG_OPTION}))
+	require.Equal(t, "unknown", res.CLType)
+	require.Nil(t, res.Value)
+}
+
+func TestNewStoredStateContract(t *testing.T) {
+	hash := bytes.Repeat([]byte{6}, 32)
+	contract := storedvalue.StoredValue{
+		Type: storedvalue.TYPE_CONTRACT,
+		Contract: storedvalue.Contract{
+			Body: []byte{0, 1, 2},
+			NamedKeys: storedvalue.NamedKeys{
+				{Name: "token", Key: storedvalue.Key{KeyID: storedvalue.KEY_ID_HASH, Hash: hash}},
+				{Name: "balance", Key: storedvalue.Key{KeyID: storedvalue.KEY_ID_UREF, Uref: storedvalue.URef{Address: hash}}},
+			},
+			ProtocolVersion: storedvalue.ProtocolVersion{Major: 1, Minor: 2, Patch: 3},
+		},
+	}
+
+	res := NewStoredState(contract)
+	require.Equal(t, StoredTypeContract, res.Type)
+	require.Nil(t, res.Account)
+	require.Equal(t, "1.2.3", res.Contract.ProtocolVersion)
+	require.Equal(t, 3, res.Contract.BodySize)
+	require.Equal(t, []NamedKeyState{
+		{Name: "token", Key: KeyState{KeyType: HASH, KeyData: sdk.ContractHashAddress(hash).String()}},
+		{Name: "balance", Key: KeyState{KeyType: UREF, KeyData: sdk.ContractUrefAddress(hash).String()}},
+	}, res.NamedKeys())
+}
@@ -14,19 +14,24 @@ const (
 	MintContractName = "mint"
 	PosContractName  = "pos"
 
-	ProxyContractName         = "client_api_proxy"
-	TransferMethodName        = "transfer_to_account"
-	PaymentMethodName         = "standard_payment"
-	BondMethodName            = "bond"
-	UnbondMethodName          = "unbond"
-	DelegateMethodName        = "delegate"
-	UndelegateMethodName      = "undelegate"
-	RedelegateMethodName      = "redelegate"
-	VoteMethodName            = "vote"
-	UnvoteMethodName          = "unvote"
-	StepMethodName            = "step"
-	ClaimRewardMethodName     = "claim_reward"
-	ClaimCommissionMethodName = "claim_commission"
+	ProxyContractName             = "client_api_proxy"
+	TransferMethodName            = "transfer_to_account"
+	MultiTransferMethodName       = "transfer_to_accounts"
+	PaymentMethodName             = "standard_payment"
+	DelegatedPaymentMethodName    = "delegated_payment"
+	BondMethodName                = "bond"
+	UnbondMethodName              = "unbond"
+	DelegateMethodName            = "delegate"
+	UndelegateMethodName          = "undelegate"
+	RedelegateMethodName          = "redelegate"
+	VoteMethodName                = "vote"
+	UnvoteMethodName              = "unvote"
+	StepMethodName                = "step"
+	ClaimRewardMethodName         = "claim_reward"
+	ClaimCommissionMethodName     = "claim_commission"
+	AddAssociatedKeyMethodName    = "add_associated_key"
+	RemoveAssociatedKeyMethodName = "remove_associated_key"
+	SetActionThresholdsMethodName = "set_action_thresholds"
 
 	SYSTEM_ACCOUNT_BALANCE       = "1000000000000000000000000000000"
 	TRANSFER_BALANCE             = "999999999999000000000000000000"
@@ -37,6 +42,9 @@ const (
 
 	DECIMAL_POINT_POS = 18
 
+	// MockEngineAddress selects the in-process mock execution engine instead of the engine socket
+	MockEngineAddress = "mock"
+
 	RewardString     = "reward"
 	CommissionString = "commission"
 	RewardValue      = true
@@ -24,6 +24,8 @@ type Validator struct {
 	ConsPubKey      crypto.PubKey  `json:"consensus_pubkey" yaml:"consensus_pubkey"` // the consensus public key of the validator; bech encoded in JSON
 	Description     Description    `json:"description" yaml:"description"`           // description terms for the validator
 	Stake           string         `json:"stake" yaml:"stake"`
+	Jailed          bool           `json:"jailed" yaml:"jailed"`                   // has the validator been jailed from the validator set?
+	CommissionRate  sdk.Dec        `json:"commission_rate" yaml:"commission_rate"` // commission rate charged to the delegators
 }
 
 // NewValidator - initialize a new validator
@@ -66,7 +68,9 @@ func (v Validator) String() string {
   Operator Address:           %s
   Validator Consensus Pubkey: %s
   Description:                %s
-  Stake:					  %s`, v.OperatorAddress, bechConsPubKey, v.Description, v.Stake)
+  Stake:					  %s
+  Jailed:                     %v
+  Commission Rate:            %s`, v.OperatorAddress, bechConsPubKey, v.Description, v.Stake, v.Jailed, v.CommissionRate)
 }
 
 // constant used in flags to indicate that description field should not be updated
@@ -138,6 +142,9 @@ type bechValidator struct {
 	ConsPubKey  string      `json:"consensus_pubkey" yaml:"consensus_pubkey"` // the bech32 consensus public key of the validator
 	Description Description `json:"description" yaml:"description"`           // description terms for the validator
 	Stake       string      `json:"stake" yaml:"stake"`
+	Jailed      bool        `json:"jailed" yaml:"jailed"`
+
+	CommissionRate sdk.Dec `json:"commission_rate" yaml:"commission_rate"`
 }
 
 // MarshalJSON marshals the validator to JSON using Bech32
@@ -152,6 +159,9 @@ func (v Validator) MarshalJSON() ([]byte, error) {
 		ConsPubKey:  bechConsPubKey,
 		Description: v.Description,
 		Stake:       v.Stake,
+		Jailed:      v.Jailed,
+
+		CommissionRate: v.CommissionRate,
 	})
 }
 
@@ -174,6 +184,8 @@ func (v *Validator) UnmarshalJSON(data []byte) error {
 		ConsPubKey:      consPubKey,
 		Description:     bv.Description,
 		Stake:           bv.Stake,
+		Jailed:          bv.Jailed,
+		CommissionRate:  bv.CommissionRate,
 	}
 	return nil
 }
@@ -200,6 +212,31 @@ func (v Validators) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+// ValidatorSet is the validators ranked by their stakes.
+// The active validators are in the validator set of the consensus, and the candidates are not.
+type ValidatorSet struct {
+	MaxValidators uint16     `json:"max_validators" yaml:"max_validators"`
+	Active        Validators `json:"active" yaml:"active"`
+	Candidates    Validators `json:"candidates" yaml:"candidates"`
+}
+
+// NewValidatorSet returns the ranked validators
+func NewValidatorSet(maxValidators uint16, active, candidates Validators) ValidatorSet {
+	return ValidatorSet{
+		MaxValidators: maxValidators,
+		Active:        active,
+		Candidates:    candidates,
+	}
+}
+
+func (s ValidatorSet) String() string {
+	return fmt.Sprintf(`Max Validators: %d
+Active (%d):
+%s
+Candidates (%d):
+%s`, s.MaxValidators, len(s.Active), s.Active, len(s.Candidates), s.Candidates)
+}
+
 type Delegator struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
 	Amount  string         `json:"amount" yaml:"amount"`
@@ -0,0 +1,52 @@
+package executionlayer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+)
+
+// rankValidators ranks the validators which are not jailed and have stakes in the engine.
+// Validators are ranked by their stakes, and by their operator addresses for the same stake.
+// The first maxValidators of them are active, and the rest are candidates.
+// The stakes of the returned validators are their stakes in the engine.
+func rankValidators(validators types.Validators, stakes map[string]string, maxValidators uint16) (active, candidates types.Validators) {
+	ranked := types.Validators{}
+	amounts := map[string]sdk.Int{}
+	for _, validator := range validators {
+		if validator.Jailed {
+			continue
+		}
+		stake := stakes[hex.EncodeToString(validator.OperatorAddress)]
+		amount, ok := sdk.NewIntFromString(stake)
+		if !ok || !amount.IsPositive() {
+			continue
+		}
+		validator.Stake = stake
+		amounts[validator.OperatorAddress.String()] = amount
+		ranked = append(ranked, validator)
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		left, right := amounts[ranked[i].OperatorAddress.String()], amounts[ranked[j].OperatorAddress.String()]
+		if !left.Equal(right) {
+			return left.GT(right)
+		}
+		return bytes.Compare(ranked[i].OperatorAddress, ranked[j].OperatorAddress) < 0
+	})
+
+	if len(ranked) <= int(maxValidators) {
+		return ranked, types.Validators{}
+	}
+	return ranked[:maxValidators], ranked[maxValidators:]
+}
+
+// GetValidatorSet returns the validators ranked by the stakes of the engine state
+func (k ExecutionLayerKeeper) GetValidatorSet(ctx sdk.Context, stakes map[string]string) types.ValidatorSet {
+	maxValidators := k.MaxValidators(ctx)
+	active, candidates := rankValidators(k.GetAllValidators(ctx), stakes, maxValidators)
+	return types.NewValidatorSet(maxValidators, active, candidates)
+}
@@ -0,0 +1,41 @@
+package executionlayer
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/executionlayer/types"
+	"github.com/hdac-io/tendermint/crypto/secp256k1"
+)
+
+func TestRankValidators(t *testing.T) {
+	newValidator := func(addr byte) types.Validator {
+		return types.NewValidator(sdk.AccAddress{addr}, secp256k1.GenPrivKey().PubKey(), types.Description{}, "")
+	}
+	first, second, third, jailed, unbonded := newValidator(1), newValidator(2), newValidator(3), newValidator(4), newValidator(5)
+	jailed.Jailed = true
+
+	stakes := map[string]string{
+		hex.EncodeToString(first.OperatorAddress):  "100",
+		hex.EncodeToString(second.OperatorAddress): "300",
+		hex.EncodeToString(third.OperatorAddress):  "100",
+		hex.EncodeToString(jailed.OperatorAddress): "500",
+	}
+	withStake := func(validator types.Validator, stake string) types.Validator {
+		validator.Stake = stake
+		return validator
+	}
+
+	// ranked by stakes, and by operator addresses for the same stake
+	validators := types.Validators{unbonded, jailed, third, first, second}
+	active, candidates := rankValidators(validators, stakes, 2)
+	require.Equal(t, types.Validators{withStake(second, "300"), withStake(first, "100")}, active)
+	require.Equal(t, types.Validators{withStake(third, "100")}, candidates)
+
+	active, candidates = rankValidators(validators, stakes, 10)
+	require.Len(t, active, 3)
+	require.Empty(t, candidates)
+}
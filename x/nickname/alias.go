@@ -8,21 +8,55 @@ const (
 	ModuleName = types.ModuleName
 	RouterKey  = types.RouterKey
 	StoreKey   = types.StoreKey
+
+	DefaultParamspace = types.DefaultParamspace
 )
 
 var (
-	NewMsgSetAccount = types.NewMsgSetNickname
-	NewMsgChangeKey  = types.NewMsgChangeKey
-	ModuleCdc        = types.ModuleCdc
-	RegisterCodec    = types.RegisterCodec
-	NewUnitAccount   = types.NewUnitAccount
-	NewName          = types.NewName
+	NewMsgSetAccount     = types.NewMsgSetNickname
+	NewMsgChangeKey      = types.NewMsgChangeKey
+	NewMsgOfferTransfer  = types.NewMsgOfferTransfer
+	NewMsgAcceptTransfer = types.NewMsgAcceptTransfer
+	NewMsgRelease        = types.NewMsgRelease
+	ModuleCdc            = types.ModuleCdc
+	RegisterCodec        = types.RegisterCodec
+	NewUnitAccount       = types.NewUnitAccount
+	NewName              = types.NewName
+	NewMsgRenew          = types.NewMsgRenew
+	NewParams            = types.NewParams
+	DefaultParams        = types.DefaultParams
+	ParamKeyTable        = types.ParamKeyTable
+	NewMsgSetRecord      = types.NewMsgSetRecord
+	NewRecord            = types.NewRecord
+	ValidateRecord       = types.ValidateRecord
+	NewMsgSetSubname     = types.NewMsgSetSubname
+	NewMsgRevokeSubname  = types.NewMsgRevokeSubname
+	GetParentName        = types.GetParentName
+	ModuleAddress        = types.ModuleAddress
+	NicknameKeyPrefix    = types.NicknameKeyPrefix
 )
 
 type (
 	MsgSetAccount       = types.MsgSetNickname
 	MsgChangeKey        = types.MsgChangeKey
+	MsgOfferTransfer    = types.MsgOfferTransfer
+	MsgAcceptTransfer   = types.MsgAcceptTransfer
+	MsgRelease          = types.MsgRelease
+	MsgRenew            = types.MsgRenew
+	Params              = types.Params
+	DeployKeeper        = types.DeployKeeper
+	MsgSetRecord        = types.MsgSetRecord
+	Record              = types.Record
+	MsgSetSubname       = types.MsgSetSubname
+	MsgRevokeSubname    = types.MsgRevokeSubname
 	QueryResUnitAccount = types.QueryResUnitAccount
 	UnitAccount         = types.UnitAccount
 	QueryReqUnitAccount = types.QueryReqUnitAccount
+	QueryReqNickname    = types.QueryReqNickname
+	QueryResNickname    = types.QueryResNickname
+	QueryReqRecords     = types.QueryReqRecords
+	QueryResRecords     = types.QueryResRecords
+	QueryReqSubnames    = types.QueryReqSubnames
+	QueryResSubnames    = types.QueryResSubnames
+	Name                = types.Name
 )
@@ -7,3 +7,8 @@ import (
 var (
 	DefaultClientHome = os.ExpandEnv("$HOME/.clif")
 )
+
+const (
+	FlagFee           = "fee"
+	FlagHolderControl = "holder-control"
+)
@@ -8,6 +8,7 @@ import (
 	"github.com/hdac-io/friday/client"
 	"github.com/hdac-io/friday/client/context"
 	"github.com/hdac-io/friday/codec"
+	sdk "github.com/hdac-io/friday/types"
 
 	"github.com/hdac-io/friday/x/nickname/types"
 )
@@ -23,6 +24,10 @@ func GetDataQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	}
 	nameserverGetDataQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryAddress(cdc),
+		GetCmdQueryNickname(cdc),
+		GetCmdQueryParams(cdc),
+		GetCmdQueryRecords(cdc),
+		GetCmdQuerySubnames(cdc),
 	)...)
 	return nameserverGetDataQueryCmd
 }
@@ -53,3 +58,111 @@ func GetCmdQueryAddress(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryNickname handles to get nicknames of an address
+func GetCmdQueryNickname(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-nickname <address>",
+		Short: "Get nicknames of given address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryData := types.QueryReqNickname{
+				Address: addr,
+			}
+			bz := cdc.MustMarshalJSON(queryData)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/getnickname", types.ModuleName), bz)
+			if err != nil {
+				fmt.Printf("could not resolve nickname - %s \n", args[0])
+				return nil
+			}
+
+			var out types.QueryResNickname
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryRecords handles to get records of a nickname
+func GetCmdQueryRecords(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-records <nickname> [<key>]",
+		Short: "Get records of given nickname, or the record of the key",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			queryData := types.QueryReqRecords{
+				Nickname: args[0],
+			}
+			if len(args) == 2 {
+				queryData.Key = args[1]
+			}
+			bz := cdc.MustMarshalJSON(queryData)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/getrecords", types.ModuleName), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResRecords
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQuerySubnames handles to get sub-names of a nickname
+func GetCmdQuerySubnames(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-subnames <nickname>",
+		Short: "Get sub-names right under given nickname",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			queryData := types.QueryReqSubnames{
+				Nickname: args[0],
+			}
+			bz := cdc.MustMarshalJSON(queryData)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/getsubnames", types.ModuleName), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResSubnames
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryParams handles to get the nickname params
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Get the prices and the registration periods of names",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params", types.ModuleName), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
@@ -23,9 +23,20 @@ func GetRootCmd(cdc *codec.Codec) *cobra.Command {
 		// Tx
 		GetCmdSetNickname(cdc),
 		GetCmdChangeKey(cdc),
+		GetCmdOfferTransfer(cdc),
+		GetCmdAcceptTransfer(cdc),
+		GetCmdRelease(cdc),
+		GetCmdRenew(cdc),
+		GetCmdSetRecord(cdc),
+		GetCmdSetSubname(cdc),
+		GetCmdRevokeSubname(cdc),
 
 		// Query
 		GetCmdQueryAddress(cdc),
+		GetCmdQueryNickname(cdc),
+		GetCmdQueryParams(cdc),
+		GetCmdQueryRecords(cdc),
+		GetCmdQuerySubnames(cdc),
 	)...)
 
 	return nicknameRootCmd
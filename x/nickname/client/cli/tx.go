@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/hdac-io/friday/client"
 	"github.com/hdac-io/friday/client/context"
@@ -11,6 +12,7 @@ import (
 	sdk "github.com/hdac-io/friday/types"
 	"github.com/hdac-io/friday/x/auth"
 	"github.com/hdac-io/friday/x/auth/client/utils"
+	cliutil "github.com/hdac-io/friday/x/executionlayer/client/util"
 
 	"github.com/hdac-io/friday/x/nickname/types"
 )
@@ -28,6 +30,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	nameserviceTxCmd.AddCommand(client.PostCommands(
 		GetCmdSetNickname(cdc),
 		GetCmdChangeKey(cdc),
+		GetCmdOfferTransfer(cdc),
+		GetCmdAcceptTransfer(cdc),
+		GetCmdRelease(cdc),
+		GetCmdRenew(cdc),
 	)...)
 
 	return nameserviceTxCmd
@@ -36,9 +42,11 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 // GetCmdSetNickname is the CLI command to register nickname from address
 func GetCmdSetNickname(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set <nickname> --from <from>",
+		Use:   "set <nickname> --from <from> [--fee <fee>]",
 		Short: fmt.Sprintf("Set nickname by address (%sxxxxxx...)", sdk.Bech32MainPrefix),
-		Args:  cobra.ExactArgs(1),
+		Long: "Set nickname by address.\n" +
+			"A short name costs the price in the params, which is paid from the EE purse with the deploy fee.",
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -47,8 +55,14 @@ func GetCmdSetNickname(cdc *codec.Codec) *cobra.Command {
 
 			fmt.Println("Register readable name for ", args[0], " -> ", addr.String())
 
+			fee, err := getFee()
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSetNickname(types.NewName(args[0]), addr)
-			err := msg.ValidateBasic()
+			msg.Fee = fee
+			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
@@ -58,6 +72,7 @@ func GetCmdSetNickname(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(FlagFee, "", "Fee of the deploy paying the price of the name, in Hdac")
 
 	return cmd
 }
@@ -92,3 +107,236 @@ func GetCmdChangeKey(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdOfferTransfer is the CLI command for offering nickname to another address
+func GetCmdOfferTransfer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer-transfer <nickname> <recipient_address> --from <owner>",
+		Short: "Offer nickname to the recipient, who takes it by accept-transfer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner := cliCtx.GetFromAddress()
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOfferTransfer(args[0], owner, recipient)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+
+	return cmd
+}
+
+// GetCmdAcceptTransfer is the CLI command for accepting offered nickname
+func GetCmdAcceptTransfer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-transfer <nickname> --from <recipient>",
+		Short: "Accept nickname offered to the recipient",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			recipient := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgAcceptTransfer(args[0], recipient)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+
+	return cmd
+}
+
+// GetCmdRelease is the CLI command for releasing nickname
+func GetCmdRelease(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release <nickname> --from <owner>",
+		Short: "Release nickname, so that others can claim it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgRelease(args[0], owner)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+
+	return cmd
+}
+
+// GetCmdRenew is the CLI command for renewing nickname
+func GetCmdRenew(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew <nickname> --from <owner> [--fee <fee>]",
+		Short: "Extend the registration of nickname by the registration period",
+		Long: "Extend the registration of nickname by the registration period.\n" +
+			"An expired name can be renewed within the grace period, and costs the price of the name again.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner := cliCtx.GetFromAddress()
+
+			fee, err := getFee()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenew(args[0], owner, fee)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().String(FlagFee, "", "Fee of the deploy paying the price of the name, in Hdac")
+
+	return cmd
+}
+
+// getFee returns the fee given by the flag in bigsun, or empty for the free names
+func getFee() (string, error) {
+	value := viper.GetString(FlagFee)
+	if value == "" {
+		return "", nil
+	}
+
+	fee, err := cliutil.ToBigsun(cliutil.Hdac(value))
+	if err != nil {
+		return "", err
+	}
+	return string(fee), nil
+}
+
+// GetCmdSetRecord is the CLI command for setting record of nickname
+func GetCmdSetRecord(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-record <nickname> <key> [<value>] --from <owner>",
+		Short: "Set record of nickname, or delete it if the value is not given",
+		Long: "Set record of nickname, or delete it if the value is not given.\n" +
+			fmt.Sprintf("Well-known keys are '%s', '%s', '%s', '%s', '%s' and '%s', and the others are free text records.\n",
+				types.RecordKeyAvatar, types.RecordKeyURL, types.RecordKeyAddress,
+				types.RecordKeyContractHash, types.RecordKeyContractUref, types.RecordKeyMemo) +
+			fmt.Sprintf("A name has up to %d records, of values up to %d bytes.", types.MaxRecords, types.MaxRecordValueLength),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner := cliCtx.GetFromAddress()
+
+			value := ""
+			if len(args) == 3 {
+				value = args[2]
+			}
+
+			msg := types.NewMsgSetRecord(args[0], owner, args[1], value)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+
+	return cmd
+}
+
+// GetCmdSetSubname is the CLI command for creating or repointing sub-name
+func GetCmdSetSubname(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-subname <subname> <address> --from <owner> [--holder-control]",
+		Short: "Create sub-name of owned nickname, e.g. pay.acme of acme, or point it at another address",
+		Long: "Create sub-name of owned nickname, e.g. pay.acme of acme, or point it at another address.\n" +
+			"With --holder-control, the holder of the sub-name changes its key and records, and creates sub-names under it as well.\n" +
+			"The owner of the parent always controls the sub-name, and the sub-name lives as long as the parent.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner := cliCtx.GetFromAddress()
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSubname(args[0], owner, address, viper.GetBool(FlagHolderControl))
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+	cmd.Flags().Bool(FlagHolderControl, false, "Let the holder of the sub-name control it")
+
+	return cmd
+}
+
+// GetCmdRevokeSubname is the CLI command for revoking sub-name
+func GetCmdRevokeSubname(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-subname <subname> --from <owner>",
+		Short: "Revoke sub-name of owned nickname, with the sub-names under it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgRevokeSubname(args[0], owner)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(client.FlagHome, DefaultClientHome, "Custom local path of client's home dir")
+
+	return cmd
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hdac-io/friday/types/rest"
 
 	"github.com/hdac-io/friday/x/auth/client/utils"
+	cliutil "github.com/hdac-io/friday/x/executionlayer/client/util"
 	"github.com/hdac-io/friday/x/nickname/types"
 )
 
@@ -20,9 +21,20 @@ const (
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
-	r.HandleFunc(fmt.Sprintf("/%s/new", restName), newNicknameHandler(cliCtx)).Methods("POST")         // New account
-	r.HandleFunc(fmt.Sprintf("/%s/change", restName), changeKeyHandler(cliCtx)).Methods("PUT")         // Change Key
-	r.HandleFunc(fmt.Sprintf("/%s/names", restName), getNameHandler(cliCtx, storeName)).Methods("GET") // Get UnitAccount
+	r.HandleFunc(fmt.Sprintf("/%s/new", restName), newNicknameHandler(cliCtx)).Methods("POST")                 // New account
+	r.HandleFunc(fmt.Sprintf("/%s/change", restName), changeKeyHandler(cliCtx)).Methods("PUT")                 // Change Key
+	r.HandleFunc(fmt.Sprintf("/%s/offer", restName), offerTransferHandler(cliCtx)).Methods("POST")             // Offer name to recipient
+	r.HandleFunc(fmt.Sprintf("/%s/accept", restName), acceptTransferHandler(cliCtx)).Methods("POST")           // Accept offered name
+	r.HandleFunc(fmt.Sprintf("/%s/release", restName), releaseHandler(cliCtx)).Methods("POST")                 // Release name
+	r.HandleFunc(fmt.Sprintf("/%s/renew", restName), renewHandler(cliCtx)).Methods("POST")                     // Renew name
+	r.HandleFunc(fmt.Sprintf("/%s/records", restName), setRecordHandler(cliCtx)).Methods("PUT")                // Set record of name
+	r.HandleFunc(fmt.Sprintf("/%s/records", restName), getRecordsHandler(cliCtx, storeName)).Methods("GET")    // Get records of name
+	r.HandleFunc(fmt.Sprintf("/%s/subnames", restName), setSubnameHandler(cliCtx)).Methods("POST")             // Set sub-name
+	r.HandleFunc(fmt.Sprintf("/%s/subnames", restName), revokeSubnameHandler(cliCtx)).Methods("DELETE")        // Revoke sub-name
+	r.HandleFunc(fmt.Sprintf("/%s/subnames", restName), getSubnamesHandler(cliCtx, storeName)).Methods("GET")  // Get sub-names of name
+	r.HandleFunc(fmt.Sprintf("/%s/params", restName), getParamsHandler(cliCtx, storeName)).Methods("GET")      // Get params
+	r.HandleFunc(fmt.Sprintf("/%s/names", restName), getNameHandler(cliCtx, storeName)).Methods("GET")         // Get UnitAccount
+	r.HandleFunc(fmt.Sprintf("/%s/nicknames", restName), getNicknameHandler(cliCtx, storeName)).Methods("GET") // Get nicknames of address
 }
 
 // --------------------------------------------------------------------------------------
@@ -31,6 +43,7 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 type newNickname struct {
 	BaseReq  rest.BaseReq `json:"base_req"`
 	Nickname string       `json:"nickname"`
+	Fee      string       `json:"fee"`
 }
 
 func newNicknameHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -58,7 +71,14 @@ func newNicknameHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// create the message
+		fee, err := toBigsun(req.Fee)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		msg := types.NewMsgSetNickname(types.NewName(req.Nickname), addr)
+		msg.Fee = fee
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -115,6 +135,290 @@ func changeKeyHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+type offerTransfer struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Nickname  string       `json:"nickname"`
+	Recipient string       `json:"recipient"`
+}
+
+func offerTransferHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req offerTransfer
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse from given address")
+			return
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse 'recipient'")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgOfferTransfer(req.Nickname, owner, recipient)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type nicknameOnly struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Nickname string       `json:"nickname"`
+}
+
+func acceptTransferHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req nicknameOnly
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse from given address")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgAcceptTransfer(req.Nickname, recipient)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func releaseHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req nicknameOnly
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse from given address")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRelease(req.Nickname, owner)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type renew struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Nickname string       `json:"nickname"`
+	Fee      string       `json:"fee"`
+}
+
+func renewHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req renew
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse from given address")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fee, err := toBigsun(req.Fee)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRenew(req.Nickname, owner, fee)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type setRecord struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Nickname string       `json:"nickname"`
+	Key      string       `json:"key"`
+	Value    string       `json:"value"`
+}
+
+func setRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setRecord
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse from given address")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetRecord(req.Nickname, owner, req.Key, req.Value)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type setSubname struct {
+	BaseReq       rest.BaseReq `json:"base_req"`
+	Subname       string       `json:"subname"`
+	Address       string       `json:"address"`
+	HolderControl bool         `json:"holder_control"`
+}
+
+func setSubnameHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setSubname
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse from given address")
+			return
+		}
+
+		address, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse sub-name address")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgSetSubname(req.Subname, owner, address, req.HolderControl)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type revokeSubname struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Subname string       `json:"subname"`
+}
+
+func revokeSubnameHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revokeSubname
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse from given address")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRevokeSubname(req.Subname, owner)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// toBigsun converts the fee in Hdac to bigsun, or returns empty for the free names
+func toBigsun(fee string) (string, error) {
+	if fee == "" {
+		return "", nil
+	}
+
+	bigsun, err := cliutil.ToBigsun(cliutil.Hdac(fee))
+	if err != nil {
+		return "", err
+	}
+	return string(bigsun), nil
+}
+
 //--------------------------------------------------------------------------------------
 // Query Handlers
 
@@ -141,3 +445,92 @@ func getNameHandler(cliCtx context.CLIContext, storeName string) http.HandlerFun
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func getNicknameHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+		straddr := vars.Get("address")
+
+		addr, err := sdk.AccAddressFromBech32(straddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		param := types.QueryReqNickname{
+			Address: addr,
+		}
+		bz, err := types.ModuleCdc.MarshalJSON(param)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/getnickname", storeName), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getRecordsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		param := types.QueryReqRecords{
+			Nickname: vars.Get("nickname"),
+			Key:      vars.Get("key"),
+		}
+		bz, err := types.ModuleCdc.MarshalJSON(param)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/getrecords", storeName), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getSubnamesHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		param := types.QueryReqSubnames{
+			Nickname: vars.Get("nickname"),
+		}
+		bz, err := types.ModuleCdc.MarshalJSON(param)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/getsubnames", storeName), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getParamsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params", storeName), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
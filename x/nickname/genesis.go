@@ -2,17 +2,32 @@ package nickname
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	sdk "github.com/hdac-io/friday/types"
 	abci "github.com/hdac-io/tendermint/abci/types"
 )
 
 type GenesisStateStorage struct {
-	UnitAccountArr []MsgSetAccount `json:"accountarr"`
+	UnitAccountArr []GenesisAccount `json:"accountarr"`
+	Subnames       []MsgSetSubname  `json:"subnames"`
+	Records        []MsgSetRecord   `json:"records"`
+	Params         Params           `json:"params"`
+}
+
+// GenesisAccount is a top-level name, restored with its expiry height as exported
+type GenesisAccount struct {
+	Nickname     Name           `json:"nickname"`
+	Address      sdk.AccAddress `json:"address"`
+	ExpiryHeight int64          `json:"expiry_height,omitempty"` // 0 if the name never expires
 }
 
 type GenesisStateLoad struct {
-	UnitAccountArr []UnitAccount `json:"accountarr"`
+	UnitAccountArr []UnitAccount   `json:"accountarr"`
+	Subnames       []MsgSetSubname `json:"subnames"`
+	Records        []MsgSetRecord  `json:"records"`
+	Params         Params          `json:"params"`
 }
 
 func NewGenesisState(accountRec []UnitAccount) GenesisStateLoad {
@@ -28,32 +43,80 @@ func ValidateGenesis(data GenesisStateStorage) error {
 			return fmt.Errorf("Invalid UnitAccount: Address: %s. Error: Missing Address", record.Address.String())
 		}
 	}
-	return nil
+	for _, subname := range data.Subnames {
+		if err := subname.ValidateBasic(); err != nil {
+			return fmt.Errorf("Invalid sub-name %s: %s", subname.Subname, err.Error())
+		}
+	}
+	for _, record := range data.Records {
+		if err := record.ValidateBasic(); err != nil {
+			return fmt.Errorf("Invalid record of %s: %s", record.Nickname, err.Error())
+		}
+	}
+	return data.Params.Validate()
 }
 
 func DefaultGenesisState() GenesisStateLoad {
 	return GenesisStateLoad{
 		UnitAccountArr: []UnitAccount{},
+		Subnames:       []MsgSetSubname{},
+		Records:        []MsgSetRecord{},
+		Params:         DefaultParams(),
 	}
 }
 
 func InitGenesis(ctx sdk.Context, k NicknameKeeper, data GenesisStateStorage) []abci.ValidatorUpdate {
+	k.SetParams(ctx, data.Params)
 	for _, record := range data.UnitAccountArr {
-		k.SetNickname(ctx, record.Nickname.MustToString(), record.Address)
+		name := record.Nickname.MustToString()
+		if !k.ImportNickname(ctx, name, record.Address, record.ExpiryHeight) {
+			panic(fmt.Sprintf("failed to import the name %s", name))
+		}
+	}
+	// the parents first
+	subnames := append([]MsgSetSubname{}, data.Subnames...)
+	sort.SliceStable(subnames, func(i, j int) bool {
+		return strings.Count(subnames[i].Subname, ".") < strings.Count(subnames[j].Subname, ".")
+	})
+	for _, subname := range subnames {
+		if !k.SetSubname(ctx, subname.Subname, subname.Owner, subname.Address, subname.HolderControl) {
+			panic(fmt.Sprintf("failed to import the sub-name %s", subname.Subname))
+		}
+	}
+	for _, record := range data.Records {
+		if !k.SetRecord(ctx, record.Nickname, record.Owner, record.Key, record.Value) {
+			panic(fmt.Sprintf("failed to import the record %s of %s", record.Key, record.Nickname))
+		}
 	}
 	return []abci.ValidatorUpdate{}
 }
 
 func ExportGenesis(ctx sdk.Context, k NicknameKeeper) GenesisStateStorage {
-	var records []MsgSetAccount
+	var records []GenesisAccount
+	var subnames []MsgSetSubname
+	var nameRecords []MsgSetRecord
 	iterator := k.GetAccountIterator(ctx)
 	for ; iterator.Valid(); iterator.Next() {
-		name := string(iterator.Key())
+		name := string(iterator.Key()[len(NicknameKeyPrefix):])
 		var acc UnitAccount
 		acc = k.GetUnitAccount(ctx, name)
 
-		convertedAcc := NewMsgSetAccount(acc.Nickname, acc.Address)
-		records = append(records, convertedAcc)
+		// the owner of the top-level name controls all the sub-names and the records under it
+		owner := acc.Address
+		for parent := acc.Parent; parent != ""; {
+			parentAcc := k.GetUnitAccount(ctx, parent)
+			owner, parent = parentAcc.Address, parentAcc.Parent
+		}
+
+		if acc.Parent != "" {
+			subnames = append(subnames, NewMsgSetSubname(name, owner, acc.Address, acc.HolderControl))
+		} else {
+			records = append(records, GenesisAccount{Nickname: acc.Nickname, Address: acc.Address, ExpiryHeight: acc.ExpiryHeight})
+		}
+
+		for _, record := range k.GetRecords(ctx, name) {
+			nameRecords = append(nameRecords, NewMsgSetRecord(name, owner, record.Key, record.Value))
+		}
 	}
-	return GenesisStateStorage{UnitAccountArr: records}
+	return GenesisStateStorage{UnitAccountArr: records, Subnames: subnames, Records: nameRecords, Params: k.GetParams(ctx)}
 }
@@ -16,9 +16,23 @@ func NewHandler(k NicknameKeeper) sdk.Handler {
 
 		switch msg := msg.(type) {
 		case MsgSetAccount:
-			return handleMsgSetAccount(ctx, k, msg, simulate)
+			return handleMsgSetAccount(ctx, k, msg, simulate, txIndex, msgIndex)
+		case MsgRenew:
+			return handleMsgRenew(ctx, k, msg, simulate, txIndex, msgIndex)
 		case MsgChangeKey:
 			return handleMsgChangeKey(ctx, k, msg, simulate)
+		case MsgOfferTransfer:
+			return handleMsgOfferTransfer(ctx, k, msg, simulate)
+		case MsgAcceptTransfer:
+			return handleMsgAcceptTransfer(ctx, k, msg, simulate)
+		case MsgRelease:
+			return handleMsgRelease(ctx, k, msg, simulate)
+		case MsgSetRecord:
+			return handleMsgSetRecord(ctx, k, msg, simulate)
+		case MsgSetSubname:
+			return handleMsgSetSubname(ctx, k, msg, simulate)
+		case MsgRevokeSubname:
+			return handleMsgRevokeSubname(ctx, k, msg, simulate)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized nameserver Msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -35,7 +49,7 @@ func getResult(ok bool, msg sdk.Msg) sdk.Result {
 	}
 
 	events := sdk.EmptyEvents()
-	event := sdk.Event{}
+	event := sdk.Event{Type: msg.Type()}
 	v := reflect.ValueOf(msg)
 	typeOfV := v.Type()
 	for i := 0; i < v.NumField(); i++ {
@@ -50,11 +64,57 @@ func getResult(ok bool, msg sdk.Msg) sdk.Result {
 }
 
 // Handle a message to set name
-func handleMsgSetAccount(ctx sdk.Context, k NicknameKeeper, msg MsgSetAccount, simulate bool) sdk.Result {
-	res := k.SetNickname(ctx, msg.Nickname.MustToString(), msg.Address)
-	processDone(ctx, simulate)
+// The registrant pays the price of the name first, and the name is set if the payment succeeds.
+func handleMsgSetAccount(ctx sdk.Context, k NicknameKeeper, msg MsgSetAccount, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	name := msg.Nickname.MustToString()
+	if !k.IsAvailable(ctx, name) {
+		processDone(ctx, simulate)
+		return getResult(false, msg)
+	}
 
-	return getResult(res, msg)
+	payResult := payNamePrice(ctx, k, "nickname:register:"+name, msg.Address, name, msg.Fee, simulate, txIndex, msgIndex)
+	if !payResult.IsOK() {
+		return payResult
+	}
+
+	res := getResult(k.SetNickname(ctx, name, msg.Address), msg)
+	res.GasUsed = payResult.GasUsed
+	return res
+}
+
+// Handle a message to renew name
+func handleMsgRenew(ctx sdk.Context, k NicknameKeeper, msg MsgRenew, simulate bool, txIndex int, msgIndex int) sdk.Result {
+	if !k.CanRenew(ctx, msg.Nickname, msg.Owner) {
+		processDone(ctx, simulate)
+		return getResult(false, msg)
+	}
+
+	payResult := payNamePrice(ctx, k, "nickname:renew:"+msg.Nickname, msg.Owner, msg.Nickname, msg.Fee, simulate, txIndex, msgIndex)
+	if !payResult.IsOK() {
+		return payResult
+	}
+
+	res := getResult(k.RenewNickname(ctx, msg.Nickname, msg.Owner), msg)
+	res.GasUsed = payResult.GasUsed
+	return res
+}
+
+// payNamePrice transfers the price of the name from the EE purse of the payer to the module account,
+// as a deploy of the proxy contract.
+// Free names need no deploy, so it releases the candidate block by itself.
+func payNamePrice(ctx sdk.Context, k NicknameKeeper, contractAddress string, payer sdk.AccAddress, name string, fee string,
+	simulate bool, txIndex int, msgIndex int) sdk.Result {
+	price := k.GetNamePrice(ctx, name)
+	if price.IsZero() {
+		processDone(ctx, simulate)
+		return sdk.Result{}
+	}
+	if k.deployKeeper == nil {
+		processDone(ctx, simulate)
+		return sdk.ErrInternal("no deploy keeper to pay the price of the name").Result()
+	}
+
+	return k.deployKeeper.PayFromPurse(ctx, contractAddress, payer, ModuleAddress, price.String(), fee, simulate, txIndex, msgIndex)
 }
 
 // Handle a message to change key
@@ -65,6 +125,54 @@ func handleMsgChangeKey(ctx sdk.Context, k NicknameKeeper, msg MsgChangeKey, sim
 	return getResult(res, msg)
 }
 
+// Handle a message to offer name to the recipient
+func handleMsgOfferTransfer(ctx sdk.Context, k NicknameKeeper, msg MsgOfferTransfer, simulate bool) sdk.Result {
+	res := k.OfferTransfer(ctx, msg.Nickname, msg.Owner, msg.Recipient)
+	processDone(ctx, simulate)
+
+	return getResult(res, msg)
+}
+
+// Handle a message to accept the offered name
+func handleMsgAcceptTransfer(ctx sdk.Context, k NicknameKeeper, msg MsgAcceptTransfer, simulate bool) sdk.Result {
+	res := k.AcceptTransfer(ctx, msg.Nickname, msg.Recipient)
+	processDone(ctx, simulate)
+
+	return getResult(res, msg)
+}
+
+// Handle a message to release name
+func handleMsgRelease(ctx sdk.Context, k NicknameKeeper, msg MsgRelease, simulate bool) sdk.Result {
+	res := k.ReleaseNickname(ctx, msg.Nickname, msg.Owner)
+	processDone(ctx, simulate)
+
+	return getResult(res, msg)
+}
+
+// Handle a message to set record of name
+func handleMsgSetRecord(ctx sdk.Context, k NicknameKeeper, msg MsgSetRecord, simulate bool) sdk.Result {
+	res := k.SetRecord(ctx, msg.Nickname, msg.Owner, msg.Key, msg.Value)
+	processDone(ctx, simulate)
+
+	return getResult(res, msg)
+}
+
+// Handle a message to set sub-name
+func handleMsgSetSubname(ctx sdk.Context, k NicknameKeeper, msg MsgSetSubname, simulate bool) sdk.Result {
+	res := k.SetSubname(ctx, msg.Subname, msg.Owner, msg.Address, msg.HolderControl)
+	processDone(ctx, simulate)
+
+	return getResult(res, msg)
+}
+
+// Handle a message to revoke sub-name
+func handleMsgRevokeSubname(ctx sdk.Context, k NicknameKeeper, msg MsgRevokeSubname, simulate bool) sdk.Result {
+	res := k.RevokeSubname(ctx, msg.Subname, msg.Owner)
+	processDone(ctx, simulate)
+
+	return getResult(res, msg)
+}
+
 func processDone(ctx sdk.Context, simulate bool) {
 	if !simulate {
 		candidateBlock := ctx.CandidateBlock()
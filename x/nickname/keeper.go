@@ -1,8 +1,12 @@
 package nickname
 
 import (
+	"strings"
+
 	"github.com/hdac-io/friday/codec"
 	"github.com/hdac-io/friday/x/auth"
+	"github.com/hdac-io/friday/x/nickname/types"
+	"github.com/hdac-io/friday/x/params"
 
 	sdk "github.com/hdac-io/friday/types"
 )
@@ -25,74 +29,429 @@ Impl:
 type NicknameKeeper struct {
 	cdc           *codec.Codec
 	storeKey      sdk.StoreKey
+	paramSpace    params.Subspace
 	AccountKeeper auth.AccountKeeper
+	deployKeeper  types.DeployKeeper
 }
 
 // NewNicknameKeeper returns AccountStore DB object
-func NewNicknameKeeper(storeKey sdk.StoreKey, cdc *codec.Codec, k auth.AccountKeeper) NicknameKeeper {
+func NewNicknameKeeper(storeKey sdk.StoreKey, cdc *codec.Codec, paramSpace params.Subspace, k auth.AccountKeeper) NicknameKeeper {
 	return NicknameKeeper{
 		storeKey:      storeKey,
 		cdc:           cdc,
+		paramSpace:    paramSpace.WithKeyTable(types.ParamKeyTable()),
 		AccountKeeper: k,
 	}
 }
 
+// SetDeployKeeper sets the keeper executing the deploys which pay the prices of the names.
+// The execution layer keeper depends on this keeper, so it is set after both are created.
+func (k *NicknameKeeper) SetDeployKeeper(deployKeeper types.DeployKeeper) {
+	k.deployKeeper = deployKeeper
+}
+
+// GetParams returns the total set of nickname parameters.
+func (k NicknameKeeper) GetParams(ctx sdk.Context) (params Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of nickname parameters.
+func (k NicknameKeeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
 // GetUnitAccount fetches the AccountInfo with the given unit account data
 // If not found, acc.UnitAccount is nil.
 func (k *NicknameKeeper) GetUnitAccount(ctx sdk.Context, name string) UnitAccount {
 	st := ctx.KVStore(k.storeKey)
-	if !st.Has([]byte(name)) {
+	val := st.Get(types.GetNicknameKey(name))
+	if val == nil {
 		return UnitAccount{}
 	}
-	val := st.Get([]byte(name))
 	var acc UnitAccount
 	k.cdc.MustUnmarshalBinaryBare(val, &acc)
 	return acc
 }
 
+// GetActiveUnitAccount fetches the unit account of the name, which is not expired.
+// If not found or expired, acc.UnitAccount is nil.
+func (k *NicknameKeeper) GetActiveUnitAccount(ctx sdk.Context, name string) UnitAccount {
+	acc := k.GetUnitAccount(ctx, name)
+	if acc.Expired(ctx.BlockHeight()) {
+		return UnitAccount{}
+	}
+	// sub-names live as long as the parent
+	if acc.Parent != "" {
+		if parent := k.GetActiveUnitAccount(ctx, acc.Parent); parent.Nickname.MustToString() == "" {
+			return UnitAccount{}
+		}
+	}
+	return acc
+}
+
+// IsAvailable returns whether the name is not stored, or expired over the grace period.
+// Names with '.' are sub-names, which are never available for registration.
+func (k *NicknameKeeper) IsAvailable(ctx sdk.Context, name string) bool {
+	if strings.Contains(name, ".") {
+		return false
+	}
+	acc := k.GetUnitAccount(ctx, name)
+	return acc.Nickname.MustToString() == "" || acc.Claimable(ctx.BlockHeight(), k.GetParams(ctx).GracePeriod)
+}
+
 // SetNickname adds the given unit account to the database.
-// It returns false if the account is already stored.
+// It returns false if the account is already stored, and not expired over the grace period.
 func (k *NicknameKeeper) SetNickname(ctx sdk.Context, name string, address sdk.AccAddress) bool {
 	// check if we already have seen it
+	if !k.IsAvailable(ctx, name) {
+		return false
+	}
+
+	// the previous owner of the lapsed name loses it, with its records and sub-names
+	if acc := k.GetUnitAccount(ctx, name); acc.Nickname.MustToString() != "" {
+		k.deleteName(ctx, name, acc)
+	}
+
+	acc := NewUnitAccount(NewName(name), address)
+	if period := k.GetParams(ctx).RegistrationPeriod; period > 0 {
+		acc.ExpiryHeight = ctx.BlockHeight() + period
+	}
+	k.setNickname(ctx, name, acc)
+	return true
+}
+
+// ImportNickname adds the name from the genesis, keeping its expiry height as exported.
+// The existing names are imported as they are, without the availability check, so that
+// the legacy names with '.' are kept. It returns false if the name is already stored.
+func (k *NicknameKeeper) ImportNickname(ctx sdk.Context, name string, address sdk.AccAddress, expiryHeight int64) bool {
+	if acc := k.GetUnitAccount(ctx, name); name == "" || acc.Nickname.MustToString() != "" {
+		return false
+	}
+
+	acc := NewUnitAccount(NewName(name), address)
+	acc.ExpiryHeight = expiryHeight
+	k.setNickname(ctx, name, acc)
+	return true
+}
+
+// setNickname stores the top-level name, and indexes it by the address
+func (k *NicknameKeeper) setNickname(ctx sdk.Context, name string, acc UnitAccount) {
+	st := ctx.KVStore(k.storeKey)
+	st.Set(types.GetNicknameKey(name), k.cdc.MustMarshalBinaryBare(acc))
+	st.Set(types.GetAddressNicknameKey(acc.Address, name), []byte{})
+}
+
+// CanRenew returns whether the owner can renew the name, which is expiring and not claimable yet
+func (k *NicknameKeeper) CanRenew(ctx sdk.Context, name string, owner sdk.AccAddress) bool {
 	acc := k.GetUnitAccount(ctx, name)
-	if acc.Nickname.MustToString() != "" {
+	return acc.Nickname.MustToString() != "" && acc.Address.Equals(owner) &&
+		acc.ExpiryHeight > 0 && !acc.Claimable(ctx.BlockHeight(), k.GetParams(ctx).GracePeriod)
+}
+
+// RenewNickname extends the registration of the name by the registration period,
+// from its expiry height or from now if it is expired already.
+func (k *NicknameKeeper) RenewNickname(ctx sdk.Context, name string, owner sdk.AccAddress) bool {
+	if !k.CanRenew(ctx, name, owner) {
 		return false
 	}
 
-	// Constructring & Marshal
-	acc = NewUnitAccount(NewName(name), address)
-	accBytes := k.cdc.MustMarshalBinaryBare(acc)
+	acc := k.GetUnitAccount(ctx, name)
+	period := k.GetParams(ctx).RegistrationPeriod
+	if period == 0 {
+		// names registered from now on never expire
+		acc.ExpiryHeight = 0
+	} else if acc.Expired(ctx.BlockHeight()) {
+		acc.ExpiryHeight = ctx.BlockHeight() + period
+	} else {
+		acc.ExpiryHeight += period
+	}
 
-	// add it to the store
 	st := ctx.KVStore(k.storeKey)
-	st.Set([]byte(name), accBytes)
+	st.Set(types.GetNicknameKey(name), k.cdc.MustMarshalBinaryBare(acc))
 
 	return true
 }
 
+// GetNamePrice returns the price of the name in bigsun
+func (k *NicknameKeeper) GetNamePrice(ctx sdk.Context, name string) sdk.Uint {
+	return k.GetParams(ctx).NamePrice(name)
+}
+
 // ChangeKey updates public key of the account and apply to the database
 func (k *NicknameKeeper) ChangeKey(ctx sdk.Context, name string, oldAddr, newAddr sdk.AccAddress) bool {
 
-	// check if we already have seen it
-	acc := k.GetUnitAccount(ctx, name)
-	if acc.Address.String() != oldAddr.String() {
+	// expired names and sub-names of expired parents are not changed
+	acc := k.GetActiveUnitAccount(ctx, name)
+	if acc.Nickname.MustToString() == "" || acc.Address.String() != oldAddr.String() {
+		return false
+	}
+	if acc.Parent != "" && !acc.HolderControl {
 		return false
 	}
 
 	k.SetAccountIfNotExists(ctx, newAddr)
-	acc = NewUnitAccount(NewName(name), newAddr)
+	acc.Address = newAddr
 	accBytes := k.cdc.MustMarshalBinaryBare(acc)
 
 	// add it to the store
 	st := ctx.KVStore(k.storeKey)
-	st.Set([]byte(name), accBytes)
+	st.Set(types.GetNicknameKey(name), accBytes)
+	st.Delete(types.GetAddressNicknameKey(oldAddr, name))
+	st.Set(types.GetAddressNicknameKey(newAddr, name), []byte{})
+
+	return true
+}
+
+// OfferTransfer offers the nickname of the owner to the recipient.
+// A new offer replaces the pending one, and the name moves only when the recipient accepts it.
+func (k *NicknameKeeper) OfferTransfer(ctx sdk.Context, name string, owner, recipient sdk.AccAddress) bool {
+	if !k.AddrCheck(ctx, name, owner) || k.GetUnitAccount(ctx, name).Parent != "" {
+		return false
+	}
+
+	st := ctx.KVStore(k.storeKey)
+	st.Set(types.GetTransferOfferKey(name), recipient.Bytes())
+
+	return true
+}
+
+// GetTransferOffer returns the recipient offered the nickname, or nil if not offered
+func (k *NicknameKeeper) GetTransferOffer(ctx sdk.Context, name string) sdk.AccAddress {
+	st := ctx.KVStore(k.storeKey)
+	bz := st.Get(types.GetTransferOfferKey(name))
+	if bz == nil {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
+
+// AcceptTransfer moves the nickname to the recipient the nickname is offered to
+func (k *NicknameKeeper) AcceptTransfer(ctx sdk.Context, name string, recipient sdk.AccAddress) bool {
+	if !recipient.Equals(k.GetTransferOffer(ctx, name)) {
+		return false
+	}
+
+	acc := k.GetActiveUnitAccount(ctx, name)
+	if acc.Nickname.MustToString() == "" {
+		return false
+	}
+	owner := acc.Address
+
+	k.SetAccountIfNotExists(ctx, recipient)
+	acc.Address = recipient
+	accBytes := k.cdc.MustMarshalBinaryBare(acc)
+
+	st := ctx.KVStore(k.storeKey)
+	st.Set(types.GetNicknameKey(name), accBytes)
+	st.Delete(types.GetAddressNicknameKey(owner, name))
+	st.Set(types.GetAddressNicknameKey(recipient, name), []byte{})
+	st.Delete(types.GetTransferOfferKey(name))
+
+	return true
+}
+
+// ReleaseNickname deletes the nickname of the owner with its sub-names, so that others can claim it.
+// Sub-names are revoked by the parent instead.
+func (k *NicknameKeeper) ReleaseNickname(ctx sdk.Context, name string, owner sdk.AccAddress) bool {
+	acc := k.GetUnitAccount(ctx, name)
+	if !k.AddrCheck(ctx, name, owner) || acc.Parent != "" {
+		return false
+	}
+
+	k.deleteName(ctx, name, acc)
 
 	return true
 }
 
+// IsController returns whether the address controls the name, which is the owner of the name,
+// the controller of the parent of the sub-name, or the holder of the sub-name if the parent allows it.
+func (k *NicknameKeeper) IsController(ctx sdk.Context, name string, address sdk.AccAddress) bool {
+	acc := k.GetActiveUnitAccount(ctx, name)
+	if acc.Nickname.MustToString() == "" {
+		return false
+	}
+	if acc.Parent == "" || acc.HolderControl {
+		if acc.Address.Equals(address) {
+			return true
+		}
+	}
+	return acc.Parent != "" && k.IsController(ctx, acc.Parent, address)
+}
+
+// SetSubname creates the sub-name pointing at the address, or repoints it.
+// The controller of the parent decides whether the holder controls the sub-name as well.
+func (k *NicknameKeeper) SetSubname(ctx sdk.Context, name string, owner, address sdk.AccAddress, holderControl bool) bool {
+	parent, ok := types.GetParentName(name)
+	if !ok || !k.IsController(ctx, parent, owner) {
+		return false
+	}
+
+	// a name registered with '.' before the sub-names is not taken over
+	acc := k.GetUnitAccount(ctx, name)
+	if acc.Nickname.MustToString() != "" && acc.Parent != parent {
+		return false
+	}
+
+	st := ctx.KVStore(k.storeKey)
+	if acc.Nickname.MustToString() != "" {
+		st.Delete(types.GetAddressNicknameKey(acc.Address, name))
+	}
+
+	k.SetAccountIfNotExists(ctx, address)
+	acc = NewUnitAccount(NewName(name), address)
+	acc.Parent = parent
+	acc.HolderControl = holderControl
+
+	st.Set(types.GetNicknameKey(name), k.cdc.MustMarshalBinaryBare(acc))
+	st.Set(types.GetAddressNicknameKey(address, name), []byte{})
+	st.Set(types.GetSubnameKey(parent, name), []byte{})
+
+	return true
+}
+
+// RevokeSubname deletes the sub-name with its own sub-names
+func (k *NicknameKeeper) RevokeSubname(ctx sdk.Context, name string, owner sdk.AccAddress) bool {
+	acc := k.GetUnitAccount(ctx, name)
+	if acc.Parent == "" || !k.IsController(ctx, acc.Parent, owner) {
+		return false
+	}
+
+	k.deleteName(ctx, name, acc)
+
+	return true
+}
+
+// GetSubnames returns the sub-names right under the nickname, in the order of the names
+func (k *NicknameKeeper) GetSubnames(ctx sdk.Context, parent string) []string {
+	st := ctx.KVStore(k.storeKey)
+	prefix := types.GetSubnamesKey(parent)
+	iterator := sdk.KVStorePrefixIterator(st, prefix)
+	defer iterator.Close()
+
+	names := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		names = append(names, string(iterator.Key()[len(prefix):]))
+	}
+	return names
+}
+
+// deleteName deletes the stored name with everything attached to it, including its sub-names
+func (k *NicknameKeeper) deleteName(ctx sdk.Context, name string, acc UnitAccount) {
+	for _, subname := range k.GetSubnames(ctx, name) {
+		k.deleteName(ctx, subname, k.GetUnitAccount(ctx, subname))
+	}
+
+	st := ctx.KVStore(k.storeKey)
+	st.Delete(types.GetNicknameKey(name))
+	st.Delete(types.GetAddressNicknameKey(acc.Address, name))
+	st.Delete(types.GetTransferOfferKey(name))
+	if acc.Parent != "" {
+		st.Delete(types.GetSubnameKey(acc.Parent, name))
+	}
+	k.deleteRecords(ctx, name)
+}
+
+// SetRecord sets the record of the nickname controlled by the owner, or deletes it if the value is empty.
+// It returns false if the name already has the maximum number of records.
+// The records stay with the name when it is transferred.
+func (k *NicknameKeeper) SetRecord(ctx sdk.Context, name string, owner sdk.AccAddress, key, value string) bool {
+	if !k.IsController(ctx, name, owner) {
+		return false
+	}
+
+	st := ctx.KVStore(k.storeKey)
+	recordKey := types.GetRecordKey(name, key)
+	if value == "" {
+		st.Delete(recordKey)
+		return true
+	}
+	if !st.Has(recordKey) && len(k.GetRecords(ctx, name)) >= types.MaxRecords {
+		return false
+	}
+	st.Set(recordKey, []byte(value))
+
+	return true
+}
+
+// GetRecord returns the value of the record of the nickname, or false if not set
+func (k *NicknameKeeper) GetRecord(ctx sdk.Context, name, key string) (string, bool) {
+	st := ctx.KVStore(k.storeKey)
+	bz := st.Get(types.GetRecordKey(name, key))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetRecords returns the records of the nickname, in the order of the keys
+func (k *NicknameKeeper) GetRecords(ctx sdk.Context, name string) []Record {
+	st := ctx.KVStore(k.storeKey)
+	prefix := types.GetRecordsKey(name)
+	iterator := sdk.KVStorePrefixIterator(st, prefix)
+	defer iterator.Close()
+
+	records := []Record{}
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, NewRecord(string(iterator.Key()[len(prefix):]), string(iterator.Value())))
+	}
+	return records
+}
+
+// deleteRecords deletes all the records of the nickname
+func (k *NicknameKeeper) deleteRecords(ctx sdk.Context, name string) {
+	st := ctx.KVStore(k.storeKey)
+	for _, record := range k.GetRecords(ctx, name) {
+		st.Delete(types.GetRecordKey(name, record.Key))
+	}
+}
+
+// GetNicknames returns the nicknames mapped to the address, in the order of the names
+func (k *NicknameKeeper) GetNicknames(ctx sdk.Context, address sdk.AccAddress) []string {
+	st := ctx.KVStore(k.storeKey)
+	prefix := types.GetAddressKey(address)
+	iterator := sdk.KVStorePrefixIterator(st, prefix)
+	defer iterator.Close()
+
+	names := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		names = append(names, string(iterator.Key()[len(prefix):]))
+	}
+	return names
+}
+
+// BuildAddressIndex builds the address -> nickname index from the nicknames stored before the index,
+// which are stored by their plain names and are moved under the nickname prefix.
+// It runs only once, and returns false if the index is already built.
+func (k *NicknameKeeper) BuildAddressIndex(ctx sdk.Context) bool {
+	st := ctx.KVStore(k.storeKey)
+	if st.Has(types.AddressIndexBuiltKey) {
+		return false
+	}
+
+	iterator := st.Iterator(types.LegacyNicknameKeyStart, nil)
+	var names []string
+	for ; iterator.Valid(); iterator.Next() {
+		names = append(names, string(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, name := range names {
+		bz := st.Get([]byte(name))
+		var acc UnitAccount
+		k.cdc.MustUnmarshalBinaryBare(bz, &acc)
+		st.Delete([]byte(name))
+		st.Set(types.GetNicknameKey(name), bz)
+		st.Set(types.GetAddressNicknameKey(acc.Address, name), []byte{})
+	}
+
+	st.Set(types.AddressIndexBuiltKey, []byte{})
+	return true
+}
+
 // AddrCheck checks account by given address
 func (k *NicknameKeeper) AddrCheck(ctx sdk.Context, name string, address sdk.AccAddress) bool {
-	acc := k.GetUnitAccount(ctx, name)
+	acc := k.GetActiveUnitAccount(ctx, name)
 	strName := acc.Nickname.MustToString()
 	if acc.Address.String() == address.String() && strName != "" {
 		return true
@@ -101,9 +460,10 @@ func (k *NicknameKeeper) AddrCheck(ctx sdk.Context, name string, address sdk.Acc
 }
 
 // GetAccountIterator get iterator for listting all accounts.
+// The keys are prefixed by NicknameKeyPrefix.
 func (k *NicknameKeeper) GetAccountIterator(ctx sdk.Context) sdk.Iterator {
 	str := ctx.KVStore(k.storeKey)
-	return sdk.KVStorePrefixIterator(str, nil)
+	return sdk.KVStorePrefixIterator(str, types.NicknameKeyPrefix)
 }
 
 // SetAccountIfNotExists runs if network has no given account
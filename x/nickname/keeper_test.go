@@ -1,9 +1,11 @@
 package nickname
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/nickname/types"
 	"github.com/hdac-io/tendermint/crypto/secp256k1"
 
 	"github.com/stretchr/testify/assert"
@@ -50,3 +52,376 @@ func TestStoreAddrCheck(t *testing.T) {
 	notverified := store.AddrCheck(input.ctx, "bryanrh", addr)
 	assert.False(notverified)
 }
+
+func TestStoreAddressIndex(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.SetNickname(input.ctx, "bryanrhee", addr)
+	store.SetNickname(input.ctx, "bryan", addr)
+	assert.Equal([]string{"bryan", "bryanrhee"}, store.GetNicknames(input.ctx, addr))
+
+	newaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.ChangeKey(input.ctx, "bryanrhee", addr, newaddr)
+	assert.Equal([]string{"bryan"}, store.GetNicknames(input.ctx, addr))
+	assert.Equal([]string{"bryanrhee"}, store.GetNicknames(input.ctx, newaddr))
+
+	// the index is not listed as accounts
+	cnt := 0
+	iterator := store.GetAccountIterator(input.ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		cnt++
+	}
+	iterator.Close()
+	assert.Equal(2, cnt)
+}
+
+func TestStoreBuildAddressIndex(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	// a nickname stored before the index
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	acc := NewUnitAccount(NewName("bryanrhee"), addr)
+	input.ctx.KVStore(store.storeKey).Set([]byte("bryanrhee"), input.cdc.MustMarshalBinaryBare(acc))
+	assert.Empty(store.GetNicknames(input.ctx, addr))
+
+	assert.True(store.BuildAddressIndex(input.ctx))
+	assert.Equal([]string{"bryanrhee"}, store.GetNicknames(input.ctx, addr))
+
+	// moved under the nickname prefix
+	assert.False(input.ctx.KVStore(store.storeKey).Has([]byte("bryanrhee")))
+	assert.True(store.AddrCheck(input.ctx, "bryanrhee", addr))
+
+	// only once
+	assert.False(store.BuildAddressIndex(input.ctx))
+}
+
+func TestStoreTransfer(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.SetNickname(input.ctx, "bryanrhee", addr)
+
+	newaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otheraddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// only the owner offers, and only the offered recipient accepts
+	assert.False(store.OfferTransfer(input.ctx, "bryanrhee", otheraddr, newaddr))
+	assert.True(store.OfferTransfer(input.ctx, "bryanrhee", addr, newaddr))
+	assert.False(store.AcceptTransfer(input.ctx, "bryanrhee", otheraddr))
+	assert.True(store.AddrCheck(input.ctx, "bryanrhee", addr))
+
+	assert.True(store.AcceptTransfer(input.ctx, "bryanrhee", newaddr))
+	assert.True(store.AddrCheck(input.ctx, "bryanrhee", newaddr))
+	assert.Nil(store.GetTransferOffer(input.ctx, "bryanrhee"))
+	assert.Empty(store.GetNicknames(input.ctx, addr))
+	assert.Equal([]string{"bryanrhee"}, store.GetNicknames(input.ctx, newaddr))
+
+	// cant accept twice
+	assert.False(store.AcceptTransfer(input.ctx, "bryanrhee", newaddr))
+}
+
+func TestStoreRelease(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.SetNickname(input.ctx, "bryanrhee", addr)
+
+	newaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.OfferTransfer(input.ctx, "bryanrhee", addr, newaddr)
+
+	assert.False(store.ReleaseNickname(input.ctx, "bryanrhee", newaddr))
+	assert.True(store.ReleaseNickname(input.ctx, "bryanrhee", addr))
+	assert.Empty(store.GetNicknames(input.ctx, addr))
+
+	// the pending offer is gone with the name
+	assert.False(store.AcceptTransfer(input.ctx, "bryanrhee", newaddr))
+
+	// claimable again
+	assert.True(store.SetNickname(input.ctx, "bryanrhee", newaddr))
+}
+
+func TestStoreNamePrice(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	store.SetParams(input.ctx, NewParams([]sdk.Uint{sdk.NewUint(300), sdk.NewUint(200), sdk.NewUint(100)}, 100, 10))
+	assert.Equal(sdk.NewUint(300), store.GetNamePrice(input.ctx, "a"))
+	assert.Equal(sdk.NewUint(100), store.GetNamePrice(input.ctx, "abc"))
+	assert.Equal(sdk.NewUint(100), store.GetNamePrice(input.ctx, "bryanrhee"))
+
+	store.SetParams(input.ctx, NewParams(nil, 100, 10))
+	assert.True(store.GetNamePrice(input.ctx, "a").IsZero())
+}
+
+func TestStoreExpiry(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+	store.SetParams(input.ctx, NewParams(nil, 100, 10))
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ctx := input.ctx.WithBlockHeight(1)
+	store.SetNickname(ctx, "bryanrhee", addr)
+	assert.Equal(int64(101), store.GetUnitAccount(ctx, "bryanrhee").ExpiryHeight)
+
+	// renewed from the expiry height
+	assert.True(store.RenewNickname(ctx.WithBlockHeight(50), "bryanrhee", addr))
+	assert.Equal(int64(201), store.GetUnitAccount(ctx, "bryanrhee").ExpiryHeight)
+
+	// expired, but still in the grace period
+	ctx = ctx.WithBlockHeight(205)
+	assert.False(store.AddrCheck(ctx, "bryanrhee", addr))
+	assert.Empty(store.GetActiveUnitAccount(ctx, "bryanrhee").Address)
+	newaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	assert.False(store.SetNickname(ctx, "bryanrhee", newaddr))
+	assert.False(store.RenewNickname(ctx, "bryanrhee", newaddr))
+
+	// renewed from now
+	assert.True(store.RenewNickname(ctx, "bryanrhee", addr))
+	assert.Equal(int64(305), store.GetUnitAccount(ctx, "bryanrhee").ExpiryHeight)
+	assert.True(store.AddrCheck(ctx, "bryanrhee", addr))
+}
+
+func TestStoreClaimExpired(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+	store.SetParams(input.ctx, NewParams(nil, 100, 10))
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ctx := input.ctx.WithBlockHeight(1)
+	store.SetNickname(ctx, "bryanrhee", addr)
+	store.OfferTransfer(ctx, "bryanrhee", addr, newaddr)
+
+	// over the grace period
+	ctx = ctx.WithBlockHeight(112)
+	assert.False(store.CanRenew(ctx, "bryanrhee", addr))
+
+	otheraddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	assert.True(store.SetNickname(ctx, "bryanrhee", otheraddr))
+	assert.True(store.AddrCheck(ctx, "bryanrhee", otheraddr))
+	assert.Empty(store.GetNicknames(ctx, addr))
+	assert.Nil(store.GetTransferOffer(ctx, "bryanrhee"))
+}
+
+func TestStoreChangeKeyExpired(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+	store.SetParams(input.ctx, NewParams(nil, 100, 10))
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	memberaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ctx := input.ctx.WithBlockHeight(1)
+	store.SetNickname(ctx, "acme", addr)
+	store.SetSubname(ctx, "pay.acme", addr, memberaddr, true)
+
+	// the lapsed owner and the holder of the sub-name of the expired parent change nothing
+	ctx = ctx.WithBlockHeight(105)
+	assert.False(store.ChangeKey(ctx, "acme", addr, newaddr))
+	assert.False(store.ChangeKey(ctx, "pay.acme", memberaddr, newaddr))
+	assert.Empty(store.GetNicknames(ctx, newaddr))
+
+	ctx = ctx.WithBlockHeight(100)
+	assert.True(store.ChangeKey(ctx, "pay.acme", memberaddr, newaddr))
+	assert.True(store.ChangeKey(ctx, "acme", addr, newaddr))
+}
+
+func TestStoreRecords(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.SetNickname(input.ctx, "bryanrhee", addr)
+	store.SetNickname(input.ctx, "bryanrhee2", addr)
+
+	// only the owner sets the records
+	otheraddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	assert.False(store.SetRecord(input.ctx, "bryanrhee", otheraddr, "url", "https://hdac.io"))
+	assert.True(store.SetRecord(input.ctx, "bryanrhee", addr, "url", "https://hdac.io"))
+	assert.True(store.SetRecord(input.ctx, "bryanrhee", addr, "avatar", "https://hdac.io/avatar.png"))
+	assert.True(store.SetRecord(input.ctx, "bryanrhee2", addr, "memo", "1234"))
+
+	value, found := store.GetRecord(input.ctx, "bryanrhee", "url")
+	assert.True(found)
+	assert.Equal("https://hdac.io", value)
+	assert.Equal([]Record{
+		NewRecord("avatar", "https://hdac.io/avatar.png"),
+		NewRecord("url", "https://hdac.io"),
+	}, store.GetRecords(input.ctx, "bryanrhee"))
+
+	// an empty value deletes the record
+	assert.True(store.SetRecord(input.ctx, "bryanrhee", addr, "url", ""))
+	_, found = store.GetRecord(input.ctx, "bryanrhee", "url")
+	assert.False(found)
+
+	// the records move with the name, and are gone when it is released
+	store.OfferTransfer(input.ctx, "bryanrhee", addr, otheraddr)
+	store.AcceptTransfer(input.ctx, "bryanrhee", otheraddr)
+	assert.Len(store.GetRecords(input.ctx, "bryanrhee"), 1)
+	store.ReleaseNickname(input.ctx, "bryanrhee", otheraddr)
+	assert.Empty(store.GetRecords(input.ctx, "bryanrhee"))
+	assert.Len(store.GetRecords(input.ctx, "bryanrhee2"), 1)
+}
+
+func TestStoreMaxRecords(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.SetNickname(input.ctx, "bryanrhee", addr)
+	for i := 0; i < types.MaxRecords; i++ {
+		assert.True(store.SetRecord(input.ctx, "bryanrhee", addr, fmt.Sprintf("addr.%d", i), "value"))
+	}
+	assert.False(store.SetRecord(input.ctx, "bryanrhee", addr, "url", "https://hdac.io"))
+
+	// the existing records are still updated
+	assert.True(store.SetRecord(input.ctx, "bryanrhee", addr, "addr.0", "new value"))
+}
+
+func TestStoreSubname(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	memberaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.SetNickname(input.ctx, "acme", addr)
+
+	// sub-names are set by the owner of the parent only
+	assert.False(store.SetNickname(input.ctx, "pay.acme", memberaddr))
+	assert.False(store.SetSubname(input.ctx, "pay.acme", memberaddr, memberaddr, false))
+	assert.False(store.SetSubname(input.ctx, "pay.nobody", addr, memberaddr, false))
+	assert.True(store.SetSubname(input.ctx, "pay.acme", addr, memberaddr, false))
+	assert.True(store.AddrCheck(input.ctx, "pay.acme", memberaddr))
+	assert.Equal([]string{"pay.acme"}, store.GetNicknames(input.ctx, memberaddr))
+	assert.Equal([]string{"pay.acme"}, store.GetSubnames(input.ctx, "acme"))
+
+	// the holder controls nothing without the permission
+	newaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	assert.False(store.ChangeKey(input.ctx, "pay.acme", memberaddr, newaddr))
+	assert.False(store.SetRecord(input.ctx, "pay.acme", memberaddr, "url", "https://hdac.io"))
+	assert.False(store.OfferTransfer(input.ctx, "pay.acme", memberaddr, newaddr))
+	assert.False(store.ReleaseNickname(input.ctx, "pay.acme", memberaddr))
+	assert.True(store.SetRecord(input.ctx, "pay.acme", addr, "url", "https://hdac.io"))
+
+	// with the permission, the holder changes its key and creates the sub-names under it
+	assert.True(store.SetSubname(input.ctx, "pay.acme", addr, memberaddr, true))
+	assert.True(store.ChangeKey(input.ctx, "pay.acme", memberaddr, newaddr))
+	assert.True(store.SetSubname(input.ctx, "eu.pay.acme", newaddr, memberaddr, false))
+	assert.True(store.AddrCheck(input.ctx, "eu.pay.acme", memberaddr))
+
+	// revoked with the sub-names under it
+	assert.False(store.RevokeSubname(input.ctx, "pay.acme", memberaddr))
+	assert.True(store.RevokeSubname(input.ctx, "pay.acme", addr))
+	assert.False(store.AddrCheck(input.ctx, "pay.acme", newaddr))
+	assert.False(store.AddrCheck(input.ctx, "eu.pay.acme", memberaddr))
+	assert.Empty(store.GetNicknames(input.ctx, newaddr))
+	assert.Empty(store.GetNicknames(input.ctx, memberaddr))
+	assert.Empty(store.GetSubnames(input.ctx, "acme"))
+	assert.Empty(store.GetRecords(input.ctx, "pay.acme"))
+}
+
+func TestStoreSubnameFollowsParent(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+	store.SetParams(input.ctx, NewParams(nil, 100, 10))
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	memberaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ctx := input.ctx.WithBlockHeight(1)
+	store.SetNickname(ctx, "acme", addr)
+	store.SetSubname(ctx, "pay.acme", addr, memberaddr, false)
+
+	// not resolved while the parent is expired
+	assert.False(store.AddrCheck(ctx.WithBlockHeight(105), "pay.acme", memberaddr))
+	assert.True(store.AddrCheck(ctx.WithBlockHeight(100), "pay.acme", memberaddr))
+
+	// gone when the parent is claimed by others
+	otheraddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ctx = ctx.WithBlockHeight(112)
+	assert.True(store.SetNickname(ctx, "acme", otheraddr))
+	assert.Empty(store.GetUnitAccount(ctx, "pay.acme").Address)
+	assert.Empty(store.GetNicknames(ctx, memberaddr))
+}
+
+func TestGenesisSubnames(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	memberaddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.SetNickname(input.ctx, "acme", addr)
+	store.SetSubname(input.ctx, "pay.acme", addr, memberaddr, false)
+	store.SetSubname(input.ctx, "a.pay.acme", addr, memberaddr, false)
+	store.SetRecord(input.ctx, "a.pay.acme", addr, "url", "https://hdac.io")
+
+	exported := ExportGenesis(input.ctx, store)
+	assert.Len(exported.UnitAccountArr, 1)
+	assert.Len(exported.Subnames, 2)
+	assert.NoError(ValidateGenesis(exported))
+
+	imported := setupTestInput()
+	InitGenesis(imported.ctx, imported.k, exported)
+	assert.True(imported.k.AddrCheck(imported.ctx, "a.pay.acme", memberaddr))
+	assert.Equal([]Record{NewRecord("url", "https://hdac.io")}, imported.k.GetRecords(imported.ctx, "a.pay.acme"))
+}
+
+func TestGenesisExpiryHeight(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+	store.SetParams(input.ctx, NewParams(nil, 100, 10))
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.SetNickname(input.ctx.WithBlockHeight(50), "acme", addr)
+
+	exported := ExportGenesis(input.ctx, store)
+	assert.Len(exported.UnitAccountArr, 1)
+	assert.Equal(int64(150), exported.UnitAccountArr[0].ExpiryHeight)
+
+	// restored as exported, not registered again at the genesis height
+	imported := setupTestInput()
+	InitGenesis(imported.ctx, imported.k, exported)
+	assert.Equal(int64(150), imported.k.GetUnitAccount(imported.ctx, "acme").ExpiryHeight)
+
+	// a rejected name fails the genesis
+	exported.UnitAccountArr = append(exported.UnitAccountArr, exported.UnitAccountArr[0])
+	rejected := setupTestInput()
+	assert.Panics(func() { InitGenesis(rejected.ctx, rejected.k, exported) })
+}
+
+func TestGenesisLegacyNames(t *testing.T) {
+	assert := assert.New(t)
+	input := setupTestInput()
+	store := input.k
+
+	// stored before the names with '.' became sub-names
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	store.setNickname(input.ctx, "hdac.io", NewUnitAccount(NewName("hdac.io"), addr))
+
+	exported := ExportGenesis(input.ctx, store)
+	assert.Len(exported.UnitAccountArr, 1)
+	assert.Empty(exported.Subnames)
+
+	imported := setupTestInput()
+	InitGenesis(imported.ctx, imported.k, exported)
+	assert.Equal(addr, imported.k.GetUnitAccount(imported.ctx, "hdac.io").Address)
+	assert.Equal([]string{"hdac.io"}, imported.k.GetNicknames(imported.ctx, addr))
+}
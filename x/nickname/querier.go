@@ -9,7 +9,11 @@ import (
 
 // Query endpoints definition for GET request
 const (
-	QueryGetAccount = "getaddress"
+	QueryGetAccount  = "getaddress"
+	QueryGetNickname = "getnickname"
+	QueryParams      = "params"
+	QueryGetRecords  = "getrecords"
+	QueryGetSubnames = "getsubnames"
 )
 
 // NewQuerier is the module level router for state queries
@@ -18,6 +22,14 @@ func NewQuerier(k NicknameKeeper) sdk.Querier {
 		switch path[0] {
 		case QueryGetAccount:
 			return queryUnitAccount(ctx, path[1:], req, k)
+		case QueryGetNickname:
+			return queryNickname(ctx, path[1:], req, k)
+		case QueryParams:
+			return queryParams(ctx, k)
+		case QueryGetRecords:
+			return queryRecords(ctx, path[1:], req, k)
+		case QueryGetSubnames:
+			return querySubnames(ctx, path[1:], req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown readable name query endpoint")
 		}
@@ -31,15 +43,100 @@ func queryUnitAccount(ctx sdk.Context, path []string, req abci.RequestQuery, k N
 		return nil, types.ErrBadQueryRequest(ModuleName)
 	}
 
-	value := k.GetUnitAccount(ctx, param.Nickname)
+	value := k.GetActiveUnitAccount(ctx, param.Nickname)
 	if value.Nickname.MustToString() == "" {
 		return nil, types.ErrNoRegisteredReadableID(ModuleName, param.Nickname)
 	}
 
 	qryvalue := QueryResUnitAccount{
-		Nickname: value.Nickname.MustToString(),
-		Address:  value.Address,
+		Nickname:     value.Nickname.MustToString(),
+		Address:      value.Address,
+		ExpiryHeight: value.ExpiryHeight,
 	}
 	res, _ := codec.MarshalJSONIndent(k.cdc, qryvalue)
 	return res, nil
 }
+
+func queryNickname(ctx sdk.Context, path []string, req abci.RequestQuery, k NicknameKeeper) ([]byte, sdk.Error) {
+	var param QueryReqNickname
+	err := ModuleCdc.UnmarshalJSON(req.Data, &param)
+	if err != nil {
+		return nil, types.ErrBadQueryRequest(ModuleName)
+	}
+
+	// expired names are not resolved
+	names := []string{}
+	for _, name := range k.GetNicknames(ctx, param.Address) {
+		if acc := k.GetActiveUnitAccount(ctx, name); acc.Nickname.MustToString() != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, types.ErrNoRegisteredAddress(ModuleName, param.Address)
+	}
+
+	qryvalue := QueryResNickname{
+		Address:   param.Address,
+		Nicknames: names,
+	}
+	res, _ := codec.MarshalJSONIndent(k.cdc, qryvalue)
+	return res, nil
+}
+
+func queryRecords(ctx sdk.Context, path []string, req abci.RequestQuery, k NicknameKeeper) ([]byte, sdk.Error) {
+	var param QueryReqRecords
+	err := ModuleCdc.UnmarshalJSON(req.Data, &param)
+	if err != nil {
+		return nil, types.ErrBadQueryRequest(ModuleName)
+	}
+
+	// records of expired names are not resolved
+	if acc := k.GetActiveUnitAccount(ctx, param.Nickname); acc.Nickname.MustToString() == "" {
+		return nil, types.ErrNoRegisteredReadableID(ModuleName, param.Nickname)
+	}
+
+	var records []Record
+	if param.Key == "" {
+		records = k.GetRecords(ctx, param.Nickname)
+	} else {
+		value, found := k.GetRecord(ctx, param.Nickname, param.Key)
+		if !found {
+			return nil, types.ErrNoRecord(ModuleName, param.Nickname, param.Key)
+		}
+		records = []Record{NewRecord(param.Key, value)}
+	}
+
+	qryvalue := QueryResRecords{
+		Nickname: param.Nickname,
+		Records:  records,
+	}
+	res, _ := codec.MarshalJSONIndent(k.cdc, qryvalue)
+	return res, nil
+}
+
+func querySubnames(ctx sdk.Context, path []string, req abci.RequestQuery, k NicknameKeeper) ([]byte, sdk.Error) {
+	var param QueryReqSubnames
+	err := ModuleCdc.UnmarshalJSON(req.Data, &param)
+	if err != nil {
+		return nil, types.ErrBadQueryRequest(ModuleName)
+	}
+
+	if acc := k.GetActiveUnitAccount(ctx, param.Nickname); acc.Nickname.MustToString() == "" {
+		return nil, types.ErrNoRegisteredReadableID(ModuleName, param.Nickname)
+	}
+
+	qryvalue := QueryResSubnames{
+		Nickname: param.Nickname,
+		Subnames: k.GetSubnames(ctx, param.Nickname),
+	}
+	res, _ := codec.MarshalJSONIndent(k.cdc, qryvalue)
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, k NicknameKeeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal params", err.Error()))
+	}
+	return res, nil
+}
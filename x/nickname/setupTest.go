@@ -48,7 +48,8 @@ func setupTestInput() testInput {
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 
 	ak.SetParams(ctx, auth.DefaultParams())
-	storeKeeper := NewNicknameKeeper(storekey, cdc, ak)
+	storeKeeper := NewNicknameKeeper(storekey, cdc, pk.Subspace(DefaultParamspace), ak)
+	storeKeeper.SetParams(ctx, DefaultParams())
 
 	return testInput{cdc: cdc, ctx: ctx, k: storeKeeper, ak: ak, pk: pk}
 }
@@ -15,4 +15,11 @@ func init() {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetNickname{}, "readablename/SetNick", nil)
 	cdc.RegisterConcrete(MsgChangeKey{}, "readablename/ChangeKey", nil)
+	cdc.RegisterConcrete(MsgOfferTransfer{}, "readablename/OfferTransfer", nil)
+	cdc.RegisterConcrete(MsgAcceptTransfer{}, "readablename/AcceptTransfer", nil)
+	cdc.RegisterConcrete(MsgRelease{}, "readablename/Release", nil)
+	cdc.RegisterConcrete(MsgRenew{}, "readablename/Renew", nil)
+	cdc.RegisterConcrete(MsgSetRecord{}, "readablename/SetRecord", nil)
+	cdc.RegisterConcrete(MsgSetSubname{}, "readablename/SetSubname", nil)
+	cdc.RegisterConcrete(MsgRevokeSubname{}, "readablename/RevokeSubname", nil)
 }
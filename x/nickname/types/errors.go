@@ -9,6 +9,9 @@ const (
 
 	CodeBadQueryRequest        sdk.CodeType = 400
 	CodeNoRegisteredReadableID sdk.CodeType = 404
+	CodeNoRegisteredAddress    sdk.CodeType = 405
+	CodeNoRecord               sdk.CodeType = 406
+	CodeInvalidRecord          sdk.CodeType = 407
 )
 
 // ErrBadQueryRequest - malform query request
@@ -20,3 +23,18 @@ func ErrBadQueryRequest(codespace sdk.CodespaceType) sdk.Error {
 func ErrNoRegisteredReadableID(codespace sdk.CodespaceType, readableid string) sdk.Error {
 	return sdk.NewError(codespace, CodeNoRegisteredReadableID, "no registered readable name: %v", readableid)
 }
+
+// ErrNoRegisteredAddress - no readable name registered to the address
+func ErrNoRegisteredAddress(codespace sdk.CodespaceType, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoRegisteredAddress, "no readable name registered to: %v", address)
+}
+
+// ErrNoRecord - no record of the key on the readable name
+func ErrNoRecord(codespace sdk.CodespaceType, readableid, key string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoRecord, "no record %v on readable name: %v", key, readableid)
+}
+
+// ErrInvalidRecord - malformed record
+func ErrInvalidRecord(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRecord, "invalid record: %v", msg)
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/hdac-io/friday/types"
+)
+
+// DeployKeeper executes the deploys transferring the prices of the names from the EE purses
+type DeployKeeper interface {
+	PayFromPurse(ctx sdk.Context, contractAddress string, payer, recipient sdk.AccAddress, amount, fee string,
+		simulate bool, txIndex int, msgIndex int) sdk.Result
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"github.com/hdac-io/casperlabs-ee-grpc-go-util/util"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
 const (
 	// ModuleName uses for schema name in key-value store
 	ModuleName = "nickname"
@@ -7,3 +13,72 @@ const (
 	// StoreKey sets schema name from ModuleName
 	StoreKey = ModuleName
 )
+
+// ModuleAddress is the EE account collecting the prices of the names
+var ModuleAddress = sdk.AccAddress(util.Blake2b256([]byte(ModuleName)))
+
+// The records of the module are stored under their own prefixes
+var (
+	// AddressKeyPrefix prefixes the address -> nickname index
+	AddressKeyPrefix = []byte{0x01}
+
+	// AddressIndexBuiltKey marks that the address index is built from the stored nicknames
+	AddressIndexBuiltKey = []byte{0x02}
+
+	// TransferOfferKeyPrefix prefixes the recipients offered the nicknames
+	TransferOfferKeyPrefix = []byte{0x03}
+
+	// RecordKeyPrefix prefixes the records of the nicknames
+	RecordKeyPrefix = []byte{0x04}
+
+	// SubnameKeyPrefix prefixes the parent -> sub-name index
+	SubnameKeyPrefix = []byte{0x05}
+
+	// NicknameKeyPrefix prefixes the nicknames
+	NicknameKeyPrefix = []byte{0x06}
+
+	// LegacyNicknameKeyStart is the lowest key of the nicknames stored by their plain names
+	// before the prefix, which are made of the Base39 charmap and never start below '-'
+	LegacyNicknameKeyStart = []byte{'-'}
+)
+
+// GetNicknameKey returns the key of the nickname
+func GetNicknameKey(name string) []byte {
+	return append(NicknameKeyPrefix, []byte(name)...)
+}
+
+// GetAddressKey returns the index prefix of the nicknames of the address
+func GetAddressKey(address sdk.AccAddress) []byte {
+	return append(AddressKeyPrefix, address.Bytes()...)
+}
+
+// GetAddressNicknameKey returns the index key of the nickname of the address
+func GetAddressNicknameKey(address sdk.AccAddress, name string) []byte {
+	return append(GetAddressKey(address), []byte(name)...)
+}
+
+// GetTransferOfferKey returns the key of the transfer offer of the nickname
+func GetTransferOfferKey(name string) []byte {
+	return append(TransferOfferKeyPrefix, []byte(name)...)
+}
+
+// GetRecordsKey returns the prefix of the records of the nickname.
+// The name is closed by 0x00, which is not in the charmap, so that it doesn't prefix the longer names.
+func GetRecordsKey(name string) []byte {
+	return append(append(RecordKeyPrefix, []byte(name)...), 0x00)
+}
+
+// GetRecordKey returns the key of the record of the nickname
+func GetRecordKey(name, key string) []byte {
+	return append(GetRecordsKey(name), []byte(key)...)
+}
+
+// GetSubnamesKey returns the index prefix of the sub-names of the nickname
+func GetSubnamesKey(parent string) []byte {
+	return append(append(SubnameKeyPrefix, []byte(parent)...), 0x00)
+}
+
+// GetSubnameKey returns the index key of the sub-name of the nickname
+func GetSubnameKey(parent, name string) []byte {
+	return append(GetSubnamesKey(parent), []byte(name)...)
+}
@@ -1,20 +1,32 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/hdac-io/friday/types"
+	eltypes "github.com/hdac-io/friday/x/executionlayer/types"
 )
 
 // RouterKey is not in sense yet
 const RouterKey = ModuleName
 
+// the messages paying the price of a name are executed as deploys,
+// and reserve the name until the deploys of the block are executed
+var (
+	_ eltypes.ReservingMsg = MsgSetNickname{}
+	_ eltypes.ReservingMsg = MsgRenew{}
+)
+
 ////////////////////////////
 /////// Add Account ////////
 ////////////////////////////
 
 // MsgSetAccount defines a SetAccount message
 type MsgSetNickname struct {
-	Nickname Name           `json:"nickname"`
-	Address  sdk.AccAddress `json:"address"`
+	Nickname Name                  `json:"nickname"`
+	Address  sdk.AccAddress        `json:"address"`
+	Fee      string                `json:"fee,omitempty"` // fee of the deploy paying the price of the name
+	Header   *eltypes.DeployHeader `json:"header,omitempty"`
 }
 
 // NewMsgSetNickname is a constructor function for MsgSetName
@@ -39,7 +51,21 @@ func (msg MsgSetNickname) ValidateBasic() sdk.Error {
 	if msg.Nickname.Equal(NewName("")) {
 		return sdk.ErrUnknownRequest("ID cannot be empty")
 	}
-	return nil
+	if strings.Contains(msg.Nickname.MustToString(), ".") {
+		return sdk.ErrUnknownRequest("Names with '.' are sub-names, which are set by the owner of the parent")
+	}
+	return validateFee(msg.Fee)
+}
+
+// GetFee returns the fee of the deploy paying the price of the name
+func (msg MsgSetNickname) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the deploy paying the price of the name
+func (msg MsgSetNickname) GetHeader() *eltypes.DeployHeader { return msg.Header }
+
+// GetReservations returns the name, which a single message of the block registers or renews
+func (msg MsgSetNickname) GetReservations() []string {
+	return []string{nameReservation(msg.Nickname.MustToString())}
 }
 
 // GetSignBytes encodes the message for signing
@@ -85,8 +111,8 @@ func (msg MsgChangeKey) ValidateBasic() sdk.Error {
 	if len(msg.OldAddress.Bytes()) == 0 || len(msg.NewAddress.Bytes()) == 0 {
 		return sdk.ErrUnknownRequest("Address cannot be empty")
 	}
-	if len(msg.Nickname) == 0 {
-		return sdk.ErrUnknownRequest("ID cannot be empty")
+	if err := validateName(msg.Nickname); err != nil {
+		return err
 	}
 	return nil
 }
@@ -100,3 +126,375 @@ func (msg MsgChangeKey) GetSignBytes() []byte {
 func (msg MsgChangeKey) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.OldAddress}
 }
+
+///////////////////////////////////
+///////// Offer Transfer //////////
+///////////////////////////////////
+
+// MsgOfferTransfer defines a message offering the nickname to a recipient
+type MsgOfferTransfer struct {
+	Nickname  string         `json:"nickname"`
+	Owner     sdk.AccAddress `json:"owner"`
+	Recipient sdk.AccAddress `json:"recipient"`
+}
+
+// NewMsgOfferTransfer is a constructor function for MsgOfferTransfer
+func NewMsgOfferTransfer(name string, owner, recipient sdk.AccAddress) MsgOfferTransfer {
+	return MsgOfferTransfer{
+		Nickname:  name,
+		Owner:     owner,
+		Recipient: recipient,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgOfferTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgOfferTransfer) Type() string { return "offertransfer" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgOfferTransfer) ValidateBasic() sdk.Error {
+	if len(msg.Owner.Bytes()) == 0 || len(msg.Recipient.Bytes()) == 0 {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if msg.Owner.Equals(msg.Recipient) {
+		return sdk.ErrUnknownRequest("Recipient cannot be the owner")
+	}
+	if err := validateName(msg.Nickname); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgOfferTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgOfferTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+///////////////////////////////////
+///////// Accept Transfer /////////
+///////////////////////////////////
+
+// MsgAcceptTransfer defines a message accepting the nickname offered to the recipient
+type MsgAcceptTransfer struct {
+	Nickname  string         `json:"nickname"`
+	Recipient sdk.AccAddress `json:"recipient"`
+}
+
+// NewMsgAcceptTransfer is a constructor function for MsgAcceptTransfer
+func NewMsgAcceptTransfer(name string, recipient sdk.AccAddress) MsgAcceptTransfer {
+	return MsgAcceptTransfer{
+		Nickname:  name,
+		Recipient: recipient,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAcceptTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAcceptTransfer) Type() string { return "accepttransfer" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAcceptTransfer) ValidateBasic() sdk.Error {
+	if len(msg.Recipient.Bytes()) == 0 {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if err := validateName(msg.Nickname); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAcceptTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAcceptTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+///////////////////////////////////
+//////////// Release //////////////
+///////////////////////////////////
+
+// MsgRelease defines a message releasing the nickname for others to claim
+type MsgRelease struct {
+	Nickname string         `json:"nickname"`
+	Owner    sdk.AccAddress `json:"owner"`
+}
+
+// NewMsgRelease is a constructor function for MsgRelease
+func NewMsgRelease(name string, owner sdk.AccAddress) MsgRelease {
+	return MsgRelease{
+		Nickname: name,
+		Owner:    owner,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRelease) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRelease) Type() string { return "release" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRelease) ValidateBasic() sdk.Error {
+	if len(msg.Owner.Bytes()) == 0 {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if err := validateName(msg.Nickname); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRelease) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRelease) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+///////////////////////////////////
+///////////// Renew ///////////////
+///////////////////////////////////
+
+// MsgRenew defines a message extending the registration of the nickname
+type MsgRenew struct {
+	Nickname string                `json:"nickname"`
+	Owner    sdk.AccAddress        `json:"owner"`
+	Fee      string                `json:"fee,omitempty"` // fee of the deploy paying the price of the name
+	Header   *eltypes.DeployHeader `json:"header,omitempty"`
+}
+
+// NewMsgRenew is a constructor function for MsgRenew
+func NewMsgRenew(name string, owner sdk.AccAddress, fee string) MsgRenew {
+	return MsgRenew{
+		Nickname: name,
+		Owner:    owner,
+		Fee:      fee,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRenew) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRenew) Type() string { return "renew" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRenew) ValidateBasic() sdk.Error {
+	if len(msg.Owner.Bytes()) == 0 {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if err := validateName(msg.Nickname); err != nil {
+		return err
+	}
+	return validateFee(msg.Fee)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRenew) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRenew) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// GetFee returns the fee of the deploy paying the price of the name
+func (msg MsgRenew) GetFee() string { return msg.Fee }
+
+// GetHeader returns the deploy header of the deploy paying the price of the name
+func (msg MsgRenew) GetHeader() *eltypes.DeployHeader { return msg.Header }
+
+// GetReservations returns the name, which a single message of the block registers or renews
+func (msg MsgRenew) GetReservations() []string {
+	return []string{nameReservation(msg.Nickname)}
+}
+
+// nameReservation returns the key which reserves the name in the candidate block
+func nameReservation(name string) string {
+	return ModuleName + "/" + name
+}
+
+// validateFee checks the fee, which may be empty for the free names
+func validateFee(fee string) sdk.Error {
+	if fee == "" {
+		return nil
+	}
+	if _, err := sdk.ParseUint(fee); err != nil {
+		return sdk.ErrUnknownRequest("Fee must be an amount in bigsun")
+	}
+	return nil
+}
+
+///////////////////////////////////
+/////////// Set Record ////////////
+///////////////////////////////////
+
+// MsgSetRecord defines a message setting a record of the nickname.
+// An empty value deletes the record.
+type MsgSetRecord struct {
+	Nickname string         `json:"nickname"`
+	Owner    sdk.AccAddress `json:"owner"`
+	Key      string         `json:"key"`
+	Value    string         `json:"value"`
+}
+
+// NewMsgSetRecord is a constructor function for MsgSetRecord
+func NewMsgSetRecord(name string, owner sdk.AccAddress, key, value string) MsgSetRecord {
+	return MsgSetRecord{
+		Nickname: name,
+		Owner:    owner,
+		Key:      key,
+		Value:    value,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetRecord) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetRecord) Type() string { return "setrecord" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetRecord) ValidateBasic() sdk.Error {
+	if len(msg.Owner.Bytes()) == 0 {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	if err := validateName(msg.Nickname); err != nil {
+		return err
+	}
+	return ValidateRecord(msg.Key, msg.Value)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetRecord) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+///////////////////////////////////
+/////////// Sub-names /////////////
+///////////////////////////////////
+
+// MsgSetSubname defines a message creating or repointing a sub-name of the nickname, e.g. "pay.acme" of "acme"
+type MsgSetSubname struct {
+	Subname       string         `json:"subname"`
+	Owner         sdk.AccAddress `json:"owner"` // controller of the parent
+	Address       sdk.AccAddress `json:"address"`
+	HolderControl bool           `json:"holder_control"`
+}
+
+// NewMsgSetSubname is a constructor function for MsgSetSubname
+func NewMsgSetSubname(name string, owner, address sdk.AccAddress, holderControl bool) MsgSetSubname {
+	return MsgSetSubname{
+		Subname:       name,
+		Owner:         owner,
+		Address:       address,
+		HolderControl: holderControl,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetSubname) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetSubname) Type() string { return "setsubname" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetSubname) ValidateBasic() sdk.Error {
+	if len(msg.Owner.Bytes()) == 0 || len(msg.Address.Bytes()) == 0 {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	return validateSubname(msg.Subname)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetSubname) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetSubname) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRevokeSubname defines a message deleting a sub-name with its own sub-names
+type MsgRevokeSubname struct {
+	Subname string         `json:"subname"`
+	Owner   sdk.AccAddress `json:"owner"` // controller of the parent
+}
+
+// NewMsgRevokeSubname is a constructor function for MsgRevokeSubname
+func NewMsgRevokeSubname(name string, owner sdk.AccAddress) MsgRevokeSubname {
+	return MsgRevokeSubname{
+		Subname: name,
+		Owner:   owner,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeSubname) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeSubname) Type() string { return "revokesubname" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRevokeSubname) ValidateBasic() sdk.Error {
+	if len(msg.Owner.Bytes()) == 0 {
+		return sdk.ErrUnknownRequest("Address cannot be empty")
+	}
+	return validateSubname(msg.Subname)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeSubname) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeSubname) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// validateSubname checks that the sub-name is a valid lower case name under a parent
+func validateSubname(name string) sdk.Error {
+	if _, ok := GetParentName(name); !ok {
+		return sdk.ErrUnknownRequest("Sub-name must be <label>.<parent>")
+	}
+	return validateName(name)
+}
+
+// validateName checks that the name is a valid lower case name
+func validateName(name string) sdk.Error {
+	if len(name) == 0 {
+		return sdk.ErrUnknownRequest("ID cannot be empty")
+	}
+	var nameObj Name
+	if err := nameObj.Init(name); err != nil {
+		return sdk.ErrUnknownRequest(err.Error())
+	}
+	if nameObj.MustToString() != name {
+		return sdk.ErrUnknownRequest("Name can only contain 0-9 a-z .-_")
+	}
+	return nil
+}
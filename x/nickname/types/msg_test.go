@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/tendermint/crypto/secp256k1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBasicName(t *testing.T) {
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	assert.Nil(t, NewMsgRelease("bryanrhee", owner).ValidateBasic())
+	assert.Nil(t, NewMsgSetRecord("pay.bryanrhee", owner, RecordKeyURL, "https://hdac.io").ValidateBasic())
+
+	// names out of the charmap would reach the other records of the store
+	for _, name := range []string{"", "Bryanrhee", "\x03acme", "\x04acme\x00avatar"} {
+		assert.NotNil(t, NewMsgChangeKey(name, owner, recipient).ValidateBasic(), name)
+		assert.NotNil(t, NewMsgOfferTransfer(name, owner, recipient).ValidateBasic(), name)
+		assert.NotNil(t, NewMsgAcceptTransfer(name, recipient).ValidateBasic(), name)
+		assert.NotNil(t, NewMsgRelease(name, owner).ValidateBasic(), name)
+		assert.NotNil(t, NewMsgRenew(name, owner, "").ValidateBasic(), name)
+		assert.NotNil(t, NewMsgSetRecord(name, owner, RecordKeyURL, "https://hdac.io").ValidateBasic(), name)
+	}
+}
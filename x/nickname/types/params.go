@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/hdac-io/friday/x/params"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+
+	// about a year, and a month of 5 second blocks
+	DefaultRegistrationPeriod = int64(6307200)
+	DefaultGracePeriod        = int64(518400)
+)
+
+var (
+	// short names cost more, and names longer than 3 characters are free
+	DefaultNamePrices = []sdk.Uint{
+		sdk.NewUintFromString("10000000000000000000000"),
+		sdk.NewUintFromString("1000000000000000000000"),
+		sdk.NewUintFromString("100000000000000000000"),
+		sdk.ZeroUint(),
+	}
+)
+
+// Parameter store keys
+var (
+	KeyNamePrices         = []byte("NamePrices")
+	KeyRegistrationPeriod = []byte("RegistrationPeriod")
+	KeyGracePeriod        = []byte("GracePeriod")
+)
+
+// ParamKeyTable for nickname module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params - used for initializing default parameter for nickname at genesis
+type Params struct {
+	NamePrices         []sdk.Uint `json:"name_prices" yaml:"name_prices"`                 // prices of names by their length in bigsun, the last one for the longer names
+	RegistrationPeriod int64      `json:"registration_period" yaml:"registration_period"` // blocks a registration or a renewal lasts, or 0 for names which never expire
+	GracePeriod        int64      `json:"grace_period" yaml:"grace_period"`               // blocks the owner can still renew an expired name, before others can claim it
+}
+
+// NewParams creates a new Params object
+func NewParams(namePrices []sdk.Uint, registrationPeriod, gracePeriod int64) Params {
+	return Params{
+		NamePrices:         namePrices,
+		RegistrationPeriod: registrationPeriod,
+		GracePeriod:        gracePeriod,
+	}
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Nickname Params:
+  NamePrices:         %s
+  RegistrationPeriod: %d
+  GracePeriod:        %d`, p.NamePrices, p.RegistrationPeriod, p.GracePeriod)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{KeyNamePrices, &p.NamePrices},
+		{KeyRegistrationPeriod, &p.RegistrationPeriod},
+		{KeyGracePeriod, &p.GracePeriod},
+	}
+}
+
+// DefaultParams returns default parameters for this module
+func DefaultParams() Params {
+	return NewParams(DefaultNamePrices, DefaultRegistrationPeriod, DefaultGracePeriod)
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	if p.RegistrationPeriod < 0 {
+		return fmt.Errorf("registration period can't be negative: %d", p.RegistrationPeriod)
+	}
+	if p.GracePeriod < 0 {
+		return fmt.Errorf("grace period can't be negative: %d", p.GracePeriod)
+	}
+	return nil
+}
+
+// NamePrice returns the price of the name by its length
+func (p Params) NamePrice(name string) sdk.Uint {
+	if len(p.NamePrices) == 0 {
+		return sdk.ZeroUint()
+	}
+	if len(name) > len(p.NamePrices) {
+		return p.NamePrices[len(p.NamePrices)-1]
+	}
+	return p.NamePrices[len(name)-1]
+}
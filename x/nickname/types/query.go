@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/hdac-io/friday/types"
 )
@@ -13,11 +14,66 @@ type QueryReqUnitAccount struct {
 
 // QueryResUnitAccount is response of a UnitAccount query
 type QueryResUnitAccount struct {
-	Nickname string         `json:"nickname"`
-	Address  sdk.AccAddress `json:"address"`
+	Nickname     string         `json:"nickname"`
+	Address      sdk.AccAddress `json:"address"`
+	ExpiryHeight int64          `json:"expiry_height"`
 }
 
 // implement fmt.Stringer
 func (r QueryResUnitAccount) String() string {
-	return fmt.Sprintf("Nickname: %s\nAddress: %s", r.Nickname, r.Address.String())
+	return fmt.Sprintf("Nickname: %s\nAddress: %s\nExpiryHeight: %d", r.Nickname, r.Address.String(), r.ExpiryHeight)
+}
+
+// QueryReqNickname payload for a reverse nickname query
+type QueryReqNickname struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// QueryResNickname is response of a reverse nickname query
+type QueryResNickname struct {
+	Address   sdk.AccAddress `json:"address"`
+	Nicknames []string       `json:"nicknames"`
+}
+
+// implement fmt.Stringer
+func (r QueryResNickname) String() string {
+	return fmt.Sprintf("Address: %s\nNicknames: %s", r.Address.String(), strings.Join(r.Nicknames, ", "))
+}
+
+// QueryReqRecords payload for a records query.
+// All the records of the nickname are returned if the key is empty.
+type QueryReqRecords struct {
+	Nickname string `json:"nickname"`
+	Key      string `json:"key,omitempty"`
+}
+
+// QueryResRecords is response of a records query
+type QueryResRecords struct {
+	Nickname string   `json:"nickname"`
+	Records  []Record `json:"records"`
+}
+
+// implement fmt.Stringer
+func (r QueryResRecords) String() string {
+	records := make([]string, len(r.Records))
+	for i, record := range r.Records {
+		records[i] = record.String()
+	}
+	return fmt.Sprintf("Nickname: %s\nRecords:\n%s", r.Nickname, strings.Join(records, "\n"))
+}
+
+// QueryReqSubnames payload for a sub-names query
+type QueryReqSubnames struct {
+	Nickname string `json:"nickname"`
+}
+
+// QueryResSubnames is response of a sub-names query
+type QueryResSubnames struct {
+	Nickname string   `json:"nickname"`
+	Subnames []string `json:"subnames"`
+}
+
+// implement fmt.Stringer
+func (r QueryResSubnames) String() string {
+	return fmt.Sprintf("Nickname: %s\nSubnames: %s", r.Nickname, strings.Join(r.Subnames, ", "))
 }
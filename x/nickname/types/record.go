@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/hdac-io/friday/types"
+)
+
+// Well-known keys of the records.
+// The other keys are free text records, e.g. "addr.btc" for the address of another chain.
+const (
+	RecordKeyAvatar       = "avatar"        // URL of the avatar image
+	RecordKeyURL          = "url"           // website
+	RecordKeyAddress      = "address"       // account address the payments to the name go to, instead of the owner
+	RecordKeyContractHash = "contract_hash" // contract hash address of the dapp
+	RecordKeyContractUref = "contract_uref" // contract uref address of the dapp
+	RecordKeyMemo         = "memo"          // memo the payments to the name should carry
+)
+
+// Size limits of the records
+const (
+	MaxRecordKeyLength   = 32
+	MaxRecordValueLength = 256
+	MaxRecords           = 16
+)
+
+var recordKeyRegexp = regexp.MustCompile(`^[a-z0-9_.-]+$`)
+
+// Record is a text or address record attached to a nickname
+type Record struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewRecord returns a new Record
+func NewRecord(key, value string) Record {
+	return Record{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// implement fmt.Stringer
+func (r Record) String() string {
+	return fmt.Sprintf("%s: %s", r.Key, r.Value)
+}
+
+// ValidateRecord checks the size of the record, and the addresses of the well-known address records.
+// An empty value deletes the record, so it is always valid.
+func ValidateRecord(key, value string) sdk.Error {
+	if len(key) == 0 || len(key) > MaxRecordKeyLength || !recordKeyRegexp.MatchString(key) {
+		return ErrInvalidRecord(DefaultCodespace,
+			fmt.Sprintf("key must be 1-%d characters of lower case alphanumerics, '_', '-' and '.'", MaxRecordKeyLength))
+	}
+	if len(value) > MaxRecordValueLength {
+		return ErrInvalidRecord(DefaultCodespace, fmt.Sprintf("value is longer than %d bytes", MaxRecordValueLength))
+	}
+	if value == "" {
+		return nil
+	}
+
+	var err error
+	switch key {
+	case RecordKeyAddress:
+		_, err = sdk.AccAddressFromBech32(value)
+	case RecordKeyContractHash:
+		_, err = sdk.ContractHashAddressFromBech32(value)
+	case RecordKeyContractUref:
+		_, err = sdk.ContractUrefAddressFromBech32(value)
+	}
+	if err != nil {
+		return ErrInvalidRecord(DefaultCodespace, fmt.Sprintf("%s must be a bech32 address: %s", key, err.Error()))
+	}
+	return nil
+}
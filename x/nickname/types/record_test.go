@@ -0,0 +1,28 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/hdac-io/friday/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRecord(t *testing.T) {
+	assert.Nil(t, ValidateRecord(RecordKeyURL, "https://hdac.io"))
+	assert.Nil(t, ValidateRecord("addr.btc", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"))
+
+	// deleting is always valid
+	assert.Nil(t, ValidateRecord(RecordKeyContractHash, ""))
+
+	assert.NotNil(t, ValidateRecord("", "value"))
+	assert.NotNil(t, ValidateRecord("URL", "value"))
+	assert.NotNil(t, ValidateRecord(strings.Repeat("a", MaxRecordKeyLength+1), "value"))
+	assert.NotNil(t, ValidateRecord(RecordKeyURL, strings.Repeat("a", MaxRecordValueLength+1)))
+
+	// the address records hold bech32 addresses
+	hashAddr := sdk.ContractHashAddress(make([]byte, 32))
+	assert.Nil(t, ValidateRecord(RecordKeyContractHash, hashAddr.String()))
+	assert.NotNil(t, ValidateRecord(RecordKeyContractUref, hashAddr.String()))
+	assert.NotNil(t, ValidateRecord(RecordKeyAddress, "bryanrhee"))
+}
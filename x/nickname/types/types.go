@@ -9,8 +9,14 @@ import (
 
 // UnitAccount used to define Unit account structure
 type UnitAccount struct {
-	Nickname Name           `json:"nick"`
-	Address  sdk.AccAddress `json:"address"`
+	Nickname     Name           `json:"nick"`
+	Address      sdk.AccAddress `json:"address"`
+	ExpiryHeight int64          `json:"expiry_height"` // 0 if the name never expires
+
+	// Sub-names are created by the controller of the parent, and live as long as the parent.
+	// The holder of the sub-name changes its address and records only if the parent allows it.
+	Parent        string `json:"parent,omitempty"`
+	HolderControl bool   `json:"holder_control,omitempty"`
 }
 
 // NewUnitAccount returns a new UnitAccount
@@ -24,5 +30,28 @@ func NewUnitAccount(name Name, address sdk.AccAddress) UnitAccount {
 // implement fmt.Stringer
 func (w UnitAccount) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Nick: %s
-Address: %s`, w.Nickname.MustToString(), w.Address))
+Address: %s
+ExpiryHeight: %d
+Parent: %s
+HolderControl: %t`, w.Nickname.MustToString(), w.Address, w.ExpiryHeight, w.Parent, w.HolderControl))
+}
+
+// Expired returns whether the name is expired at the height
+func (w UnitAccount) Expired(height int64) bool {
+	return w.ExpiryHeight > 0 && height > w.ExpiryHeight
+}
+
+// Claimable returns whether the name is expired over the grace period at the height, so that others can claim it
+func (w UnitAccount) Claimable(height, gracePeriod int64) bool {
+	return w.ExpiryHeight > 0 && height > w.ExpiryHeight+gracePeriod
+}
+
+// GetParentName returns the parent of the sub-name, e.g. "acme" of "pay.acme".
+// It returns false if the name is not a well-formed sub-name.
+func GetParentName(name string) (string, bool) {
+	i := strings.Index(name, ".")
+	if i <= 0 || strings.HasSuffix(name, ".") || strings.Contains(name, "..") {
+		return "", false
+	}
+	return name[i+1:], true
 }
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetParentName(t *testing.T) {
+	parent, ok := GetParentName("pay.acme")
+	assert.True(t, ok)
+	assert.Equal(t, "acme", parent)
+
+	parent, ok = GetParentName("eu.pay.acme")
+	assert.True(t, ok)
+	assert.Equal(t, "pay.acme", parent)
+
+	for _, name := range []string{"acme", ".acme", "pay.", "pay..acme"} {
+		_, ok = GetParentName(name)
+		assert.False(t, ok, name)
+	}
+}
@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -127,3 +128,42 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+type testValidatedParams struct {
+	testParams
+}
+
+func (tp testValidatedParams) Validate() error {
+	if tp.MaxValidators == 0 {
+		return errors.New("max validators must be positive")
+	}
+	if tp.SlashingRate.Downtime > tp.SlashingRate.DoubleSign {
+		return errors.New("downtime slashing rate must not exceed the double sign slashing rate")
+	}
+	return nil
+}
+
+func TestProposalHandlerValidated(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testValidatedParams{}),
+	)
+	ss.SetParamSet(input.ctx, &testValidatedParams{testParams{1, testParamsSlashingRate{10, 7}}})
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	tp := testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "0"))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	// validated with the other parameters as stored
+	tp = testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 11}`))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	var param uint16
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(1), param)
+
+	tp = testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "5"))
+	require.NoError(t, hdlr(input.ctx, tp))
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(5), param)
+}
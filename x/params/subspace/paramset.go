@@ -13,3 +13,9 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// Interface for param sets which validate the updates of their parameters
+type ValidatedParamSet interface {
+	ParamSet
+	Validate() error
+}
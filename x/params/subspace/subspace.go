@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"bytes"
 	"errors"
 	"reflect"
 
@@ -193,6 +194,11 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	if err != nil {
 		return err
 	}
+	if attr.set != nil {
+		if err := s.validateParamSet(ctx, attr.set, key, dest); err != nil {
+			return err
+		}
+	}
 
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
@@ -201,6 +207,19 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	return nil
 }
 
+// validateParamSet validates the param set of the type with the parameter of the key replaced by the value
+func (s Subspace) validateParamSet(ctx sdk.Context, ty reflect.Type, key []byte, value interface{}) error {
+	ps := reflect.New(ty).Interface().(ValidatedParamSet)
+	for _, pair := range ps.ParamSetPairs() {
+		if bytes.Equal(pair.Key, key) {
+			reflect.ValueOf(pair.Value).Elem().Set(reflect.ValueOf(value).Elem())
+		} else {
+			s.GetIfExists(ctx, pair.Key, pair.Value)
+		}
+	}
+	return ps.Validate()
+}
+
 // SetWithSubkey set a parameter with a key and subkey
 // Checks parameter type only over the key
 func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) {
@@ -6,6 +6,9 @@ import (
 
 type attribute struct {
 	ty reflect.Type
+
+	// type of the param set validating the parameter, if any
+	set reflect.Type
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -68,10 +71,16 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	return t
 }
 
-// Register multiple pairs from ParamSet
+// Register multiple pairs from ParamSet.
+// If the ParamSet is a ValidatedParamSet, the updates of its parameters are validated on the whole set.
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
 		t = t.RegisterType(kvp.Key, kvp.Value)
+		if _, ok := ps.(ValidatedParamSet); ok {
+			attr := t.m[string(kvp.Key)]
+			attr.set = reflect.TypeOf(ps).Elem()
+			t.m[string(kvp.Key)] = attr
+		}
 	}
 	return t
 }